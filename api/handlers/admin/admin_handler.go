@@ -0,0 +1,47 @@
+// Package admin handler is the representation layer for the api server administration
+package admin
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/pkg/configs"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/shared"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type logLevelDto struct {
+	Level string `json:"level"`
+}
+
+// Config returns the effective configuration of the api server with secrets redacted
+func Config(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(configs.Settings.Redacted()))
+}
+
+// LogLevels returns the current log level of every component
+func LogLevels(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(logger.Levels()))
+}
+
+// SetLogLevel changes the log level of a single component at runtime
+// 1-validate request body
+// 2-set the component level, return bad request if the component or the level is unknown
+// 3-return the levels of all components
+func SetLogLevel(c *fiber.Ctx) error {
+	dto := new(logLevelDto)
+	if err := c.BodyParser(dto); err != nil {
+		badReq := restErrors.NewBadRequestError("invalid request body")
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+
+	component := c.Params("component")
+	if err := logger.SetLevel(component, dto.Level); err != nil {
+		badReq := restErrors.NewBadRequestError(err.Error())
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+	logger.Info("ADMIN_LOG_LEVEL", "log level changed at runtime", zap.String("component", component), zap.String("level", dto.Level))
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(logger.Levels()))
+}
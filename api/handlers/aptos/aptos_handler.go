@@ -10,7 +10,10 @@ import (
 	"github.com/kotalco/community-api/internal/aptos"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
 	"io/ioutil"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -37,14 +40,23 @@ const (
 )
 
 var (
-	service   = aptos.NewAptosService()
-	k8sClient = k8s.NewClientService()
+	service            = aptos.NewAptosService()
+	k8sClient          = k8s.NewClientService()
+	statefulSetService = statefulset.NewService()
 )
 
 // Get returns a single aptos node by name
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(aptosv1alpha1.Node)
-	return c.JSON(shared.NewResponse(new(aptos.AptosDto).FromAptosNode(node)))
+
+	dto := new(aptos.AptosDto).FromAptosNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.JSON(shared.NewResponse(dto))
 }
 
 // List returns all aptos nodes
@@ -53,7 +65,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodeList, err := service.List(c.Locals("namespace").(string))
+	nodeList, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -66,7 +78,16 @@ func List(c *fiber.Ctx) error {
 	c.Set("Access-Control-Expose-Headers", "X-Total-Count")
 	c.Set("X-Total-Count", fmt.Sprintf("%d", len(nodeList.Items)))
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(aptos.AptosListDto).FromAptosNode(nodeList.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(aptos.AptosListDto).FromAptosNode(nodeList.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create created aptos node from given specs
@@ -82,7 +103,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -97,9 +118,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(aptosv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -109,7 +134,7 @@ func Update(c *fiber.Ctx) error {
 
 // Count returns total number of nodes
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -124,7 +149,7 @@ func Count(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(aptosv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -135,6 +160,7 @@ func Delete(c *fiber.Ctx) error {
 // Stats returns a websocket that emits aptos stats
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	name := c.Params("name")
 	node := &aptosv1alpha1.Node{}
@@ -142,7 +168,7 @@ func Stats(c *websocket.Conn) {
 		Namespace: c.Locals("namespace").(string),
 		Name:      name,
 	}
-	err := k8sClient.Get(context.Background(), nameSpacedName, node)
+	err := k8sClient.Get(ctx, nameSpacedName, node)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			c.WriteJSON(fiber.Map{
@@ -169,7 +195,7 @@ func Stats(c *websocket.Conn) {
 		results := make(chan result, reqCount)
 
 		for i := 0; i < reqCount; i++ {
-			go worker(jobs, results)
+			go worker(ctx, jobs, results)
 		}
 
 		jobs <- request{name: "ledgerInfo", url: fmt.Sprintf("http://%s.%s:%d/v1", node.Name, nameSpacedName.Namespace, node.Spec.APIPort)}
@@ -232,34 +258,37 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		time.Sleep(time.Second * 3)
+		if !server.Sleep(ctx, time.Second*3) {
+			return
+		}
 	}
 }
 
 // worker is a  collection of threads for the aptos node stats
-func worker(jobs <-chan request, results chan<- result) {
-	chanRes := result{}
+func worker(ctx context.Context, jobs <-chan request, results chan<- result) {
 	for job := range jobs {
-		chanRes.name = job.name
+		chanRes := result{name: job.name}
 
-		client := http.Client{
-			Timeout: 20 * time.Second,
-		}
-		req, err := http.NewRequest(http.MethodGet, job.url, bytes.NewReader([]byte(nil)))
+		client := tracing.HTTPClient(ctx, 20*time.Second)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, job.url, bytes.NewReader([]byte(nil)))
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 		resp, err := client.Do(req)
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 
 		responseData, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 		chanRes.data = responseData
 		results <- chanRes
@@ -272,7 +301,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
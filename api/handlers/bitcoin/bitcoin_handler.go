@@ -11,7 +11,10 @@ import (
 	"github.com/kotalco/community-api/internal/core/secret"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	bitcoinv1alpha1 "github.com/kotalco/kotal/apis/bitcoin/v1alpha1"
 	"github.com/ybbus/jsonrpc/v2"
 	apiError "k8s.io/apimachinery/pkg/api/errors"
@@ -38,15 +41,24 @@ const (
 )
 
 var (
-	service       = bitcoin.NewBitcoinService()
-	secretService = secret.NewSecretService()
-	k8sClient     = k8s.NewClientService()
+	service            = bitcoin.NewBitcoinService()
+	secretService      = secret.NewSecretService()
+	k8sClient          = k8s.NewClientService()
+	statefulSetService = statefulset.NewService()
 )
 
 // Get returns a single bitcoin node by name
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(bitcoinv1alpha1.Node)
-	return c.JSON(shared.NewResponse(new(bitcoin.BitcoinDto).FromBitcoinNode(node)))
+
+	dto := new(bitcoin.BitcoinDto).FromBitcoinNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.JSON(shared.NewResponse(dto))
 }
 
 // List returns all bitcoin nodes
@@ -55,7 +67,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodeList, err := service.List(c.Locals("namespace").(string))
+	nodeList, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -68,7 +80,16 @@ func List(c *fiber.Ctx) error {
 	c.Set("Access-Control-Expose-Headers", "X-Total-Count")
 	c.Set("X-Total-Count", fmt.Sprintf("%d", len(nodeList.Items)))
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(bitcoin.BitcoinListDto).FromBitcoinNode(nodeList.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(bitcoin.BitcoinListDto).FromBitcoinNode(nodeList.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create created bitcoin node from given specs
@@ -85,7 +106,7 @@ func Create(c *fiber.Ctx) error {
 	}
 
 	//check for bitcoin json rpc default user secret
-	_, err := secretService.Get(types.NamespacedName{
+	_, err := secretService.Get(c.UserContext(), types.NamespacedName{
 		Name:      bitcoin.BitcoinJsonRpcDefaultUserPasswordName,
 		Namespace: dto.Namespace,
 	})
@@ -94,7 +115,7 @@ func Create(c *fiber.Ctx) error {
 			return c.Status(err.StatusCode()).JSON(err)
 		}
 		//create bitcoin user default secret
-		_, err = secretService.Create(secret.SecretDto{
+		_, err = secretService.Create(c.UserContext(), secret.SecretDto{
 			MetaDataDto: k8s.MetaDataDto{Name: bitcoin.BitcoinJsonRpcDefaultUserPasswordName, Namespace: dto.Namespace},
 			Type:        "password",
 			Data:        map[string]string{"password": bitcoin.BitcoinJsonRpcDefaultUserPasswordSecret},
@@ -104,7 +125,7 @@ func Create(c *fiber.Ctx) error {
 		}
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -119,9 +140,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(bitcoinv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -131,7 +156,7 @@ func Update(c *fiber.Ctx) error {
 
 // Count returns total number of nodes
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -146,7 +171,7 @@ func Count(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(bitcoinv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -160,7 +185,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -172,13 +197,14 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 // Stats returns a websocket that emits bitcoin block and node count stats
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 	name := c.Params("name")
 	node := &bitcoinv1alpha1.Node{}
 	nameSpacedName := types.NamespacedName{
 		Namespace: c.Locals("namespace").(string),
 		Name:      name,
 	}
-	err := k8sClient.Get(context.Background(), nameSpacedName, node)
+	err := k8sClient.Get(ctx, nameSpacedName, node)
 	if err != nil {
 		if apiError.IsNotFound(err) {
 			c.WriteJSON(fiber.Map{
@@ -204,7 +230,7 @@ func Stats(c *websocket.Conn) {
 		results := make(chan result, 2)
 
 		for i := 0; i < 2; i++ {
-			go worker(jobs, results)
+			go worker(ctx, jobs, results)
 		}
 
 		endpoint := fmt.Sprintf("http://%s:%s@%s.%s:%d/", bitcoin.BitcoinJsonRpcDefaultUserName, bitcoin.BitcoinJsonRpcDefaultUserPasswordSecret, nameSpacedName.Name, nameSpacedName.Namespace, node.Spec.RPCPort)
@@ -257,17 +283,19 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		time.Sleep(time.Second * 3)
+		if !server.Sleep(ctx, time.Second*3) {
+			return
+		}
 	}
 }
 
 // worker is a  collection of threads for the bitcoin stats
-func worker(jobs <-chan request, results chan<- result) {
+func worker(ctx context.Context, jobs <-chan request, results chan<- result) {
 	chanRes := result{}
 	for job := range jobs {
 		chanRes.name = job.name
 
-		client := jsonrpc.NewClient(job.endpoint)
+		client := jsonrpc.NewClientWithOpts(job.endpoint, &jsonrpc.RPCClientOpts{HTTPClient: tracing.HTTPClient(ctx, 0)})
 
 		res, err := client.Call(job.method)
 		if err != nil {
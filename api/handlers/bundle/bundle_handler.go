@@ -0,0 +1,52 @@
+// Package bundle handler is the representation layer for the bundle domain
+// downloads the support bundle of a resource of any protocol
+package bundle
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/bundle"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nameKeyword       = "name"
+	bundleContentType = "application/gzip"
+)
+
+var service = bundle.NewBundleService()
+
+// Get returns the handler downloading the support bundle of a resource of kind gvk
+// 1-call service to collect the manifests, config, status, events, logs and metrics of the resource with its secret values redacted
+// 2-stream the bundle as a tar.gz attachment
+func Get(gvk schema.GroupVersionKind) fiber.Handler {
+	kind, ok := k8s.KindFor(gvk)
+	if !ok {
+		panic(fmt.Sprintf("can't bundle resources of unknown kind %s", gvk))
+	}
+
+	return func(c *fiber.Ctx) error {
+		name := types.NamespacedName{Namespace: c.Locals("namespace").(string), Name: c.Params(nameKeyword)}
+		dto, err := service.Get(c.UserContext(), kind, name)
+		if err != nil {
+			return c.Status(err.StatusCode()).JSON(err)
+		}
+
+		c.Attachment(fmt.Sprintf("%s-support-bundle-%s.tar.gz", dto.Name, dto.CreatedAt.Format("20060102150405")))
+		c.Set(fiber.HeaderContentType, bundleContentType)
+		c.Status(http.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			if err := dto.Write(w); err != nil {
+				logger.Error("SUPPORT_BUNDLE", err)
+				return
+			}
+			w.Flush()
+		})
+		return nil
+	}
+}
@@ -0,0 +1,16 @@
+// Package capabilities handler is the representation layer for the protocols the cluster can serve
+package capabilities
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/capabilities"
+	"github.com/kotalco/community-api/pkg/shared"
+	"net/http"
+)
+
+var service = capabilities.NewCapabilitiesService()
+
+// Get returns the protocols, resources, networks and clients available in the cluster
+func Get(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(service.Get()))
+}
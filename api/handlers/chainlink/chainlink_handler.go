@@ -7,6 +7,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/kotalco/community-api/internal/chainlink"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
 	"github.com/kotalco/community-api/pkg/shared"
 	chainlinkv1alpha1 "github.com/kotalco/kotal/apis/chainlink/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,14 +20,25 @@ const (
 	nameKeyword = "name"
 )
 
-var service = chainlink.NewChainLinkService()
+var (
+	service            = chainlink.NewChainLinkService()
+	statefulSetService = statefulset.NewService()
+)
 
 // Get returns a single chainlink node by name
 // 1-get the node validated from ValidateNodeExist method
 // 2-marshall node to dto and format the response
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(chainlinkv1alpha1.Node)
-	return c.JSON(shared.NewResponse(new(chainlink.ChainlinkDto).FromChainlinkNode(node)))
+
+	dto := new(chainlink.ChainlinkDto).FromChainlinkNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.JSON(shared.NewResponse(dto))
 }
 
 // Create creates chainlink node from the given spec
@@ -47,7 +59,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -67,9 +79,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(chainlinkv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -88,7 +104,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodeList, err := service.List(c.Locals("namespace").(string))
+	nodeList, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -101,7 +117,16 @@ func List(c *fiber.Ctx) error {
 	c.Set("Access-Control-Expose-Headers", "X-Total-Count")
 	c.Set("X-Total-Count", fmt.Sprintf("%d", len(nodeList.Items)))
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(chainlink.ChainlinkListDto).FromChainlinkNode(nodeList.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(chainlink.ChainlinkListDto).FromChainlinkNode(nodeList.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Delete a single chainlink node by name
@@ -111,7 +136,7 @@ func List(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(chainlinkv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -124,7 +149,7 @@ func Delete(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -145,7 +170,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -0,0 +1,60 @@
+// Package clone handler is the representation layer for the clone domain
+// copies resources of any protocol under a new name
+package clone
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/clone"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/shared"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	nameKeyword = "name"
+)
+
+var service = clone.NewCloneService()
+
+// Clone returns the handler cloning a resource of kind gvk
+// 1-validate the clone name and the secrets policy, share by default
+// 2-call service to create the copy with its overrides and secrets
+// 3-format the copy and its secrets using NewResponse
+func Clone(gvk schema.GroupVersionKind) fiber.Handler {
+	kind, ok := k8s.KindFor(gvk)
+	if !ok {
+		panic(fmt.Sprintf("can't clone resources of unknown kind %s", gvk))
+	}
+
+	return func(c *fiber.Ctx) error {
+		dto := new(clone.CloneDto)
+		if err := c.BodyParser(dto); err != nil {
+			badReq := restErrors.NewBadRequestError("invalid request body")
+			return c.Status(badReq.StatusCode()).JSON(badReq)
+		}
+
+		meta := k8s.MetaDataDto{Name: dto.Name}
+		if err := meta.Validate(); err != nil {
+			return c.Status(err.StatusCode()).JSON(err)
+		}
+		if dto.Secrets == "" {
+			dto.Secrets = clone.SecretsShare
+		}
+		if dto.Secrets != clone.SecretsShare && dto.Secrets != clone.SecretsDuplicate {
+			badReq := restErrors.NewBadRequestError(fmt.Sprintf("secrets must be %s or %s", clone.SecretsShare, clone.SecretsDuplicate))
+			return c.Status(badReq.StatusCode()).JSON(badReq)
+		}
+		dto.Namespace = c.Locals("namespace").(string)
+
+		result, err := service.Clone(c.UserContext(), kind, c.Params(nameKeyword), *dto)
+		if err != nil {
+			return c.Status(err.StatusCode()).JSON(err)
+		}
+
+		return c.Status(http.StatusCreated).JSON(shared.NewResponse(result))
+	}
+}
@@ -0,0 +1,40 @@
+// Package name handler is the representation layer for the name domain
+// reports whether a resource name is available in the namespace
+package name
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/core/name"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/shared"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nameKeyword = "name"
+)
+
+var service = name.NewNameService()
+
+// Available reports whether the name is used by any kotal resource or the resources generated for them
+// 1-validate the name
+// 2-call service to look the name up
+// 3-marshall the result and format the response
+func Available(c *fiber.Ctx) error {
+	meta := k8s.MetaDataDto{Name: c.Params(nameKeyword)}
+	if err := meta.Validate(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	dto, err := service.Get(c.UserContext(), types.NamespacedName{
+		Name:      meta.Name,
+		Namespace: c.Locals("namespace").(string),
+	})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
+}
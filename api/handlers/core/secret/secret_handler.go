@@ -40,7 +40,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page")) // default page to 0
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	secrets, err := service.List(c.Locals("namespace").(string))
+	secrets, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -84,7 +84,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	secretModel, err := service.Create(*dto)
+	secretModel, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -99,7 +99,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	secretModel := c.Locals("secret").(corev1.Secret)
 
-	err := service.Delete(&secretModel)
+	err := service.Delete(c.UserContext(), &secretModel)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -116,7 +116,7 @@ func Update(c *fiber.Ctx) error {
 // 1-call secrets service to count secrets items
 // 2-set the X-Total-Count header with default to 0
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -137,7 +137,7 @@ func ValidateSecretExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	secretModel, err := service.Get(nameSpacedName)
+	secretModel, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -35,7 +35,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page")) // default page to 0
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	storageClassList, err := service.List()
+	storageClassList, err := service.List(c.UserContext())
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -74,7 +74,7 @@ func Update(c *fiber.Ctx) error {
 // 2-return not found if it's not
 // 3-save the storage class to local with the key storage_class to be used by the other handlers
 func ValidateStorageClassExist(c *fiber.Ctx) error {
-	storageClass, err := service.Get(c.Params(nameKeyword))
+	storageClass, err := service.Get(c.UserContext(), c.Params(nameKeyword))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
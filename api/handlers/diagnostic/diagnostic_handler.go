@@ -0,0 +1,31 @@
+// Package diagnostic handler is the representation layer for the diagnostic domain
+// explains why a resource of any protocol crashed
+package diagnostic
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/diagnostic"
+	"github.com/kotalco/community-api/pkg/shared"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nameKeyword = "name"
+)
+
+var service = diagnostic.NewDiagnosticService()
+
+// Get returns the crash diagnostics of a resource
+// 1-call service to get the last termination and logs of the containers of the resource pod
+// 2-format the diagnostics with the hints matching them using NewResponse
+func Get(c *fiber.Ctx) error {
+	name := types.NamespacedName{Namespace: c.Locals("namespace").(string), Name: c.Params(nameKeyword)}
+	dto, err := service.Get(c.UserContext(), name)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
+}
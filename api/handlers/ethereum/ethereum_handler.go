@@ -9,7 +9,10 @@ import (
 	"github.com/gofiber/websocket/v2"
 	"github.com/kotalco/community-api/internal/ethereum"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
 	"github.com/ybbus/jsonrpc/v2"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,7 +29,10 @@ const (
 	nameKeyword = "name"
 )
 
-var service = ethereum.NewEthereumService()
+var (
+	service            = ethereum.NewEthereumService()
+	statefulSetService = statefulset.NewService()
+)
 
 // Get returns a single ethereum node by name
 // 1-get the node validated from ValidateNodeExist method
@@ -34,7 +40,14 @@ var service = ethereum.NewEthereumService()
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(ethereumv1alpha1.Node)
 
-	return c.JSON(shared.NewResponse(new(ethereum.EthereumDto).FromEthereumNode(node)))
+	dto := new(ethereum.EthereumDto).FromEthereumNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.JSON(shared.NewResponse(dto))
 }
 
 // Create creates ethereum node from the given spec
@@ -55,7 +68,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -75,9 +88,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(ethereumv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -94,7 +111,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodes, err := service.List(c.Locals("namespace").(string))
+	nodes, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
@@ -108,7 +125,16 @@ func List(c *fiber.Ctx) error {
 		return nodes.Items[j].CreationTimestamp.Before(&nodes.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(ethereum.EthereumListDto).FromEthereumNode(nodes.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(ethereum.EthereumListDto).FromEthereumNode(nodes.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Delete a single ethereum node by name
@@ -118,7 +144,7 @@ func List(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(ethereumv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -131,7 +157,7 @@ func Delete(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -144,6 +170,7 @@ func Count(c *fiber.Ctx) error {
 
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	type Result struct {
 		Error string `json:"error,omitempty"`
@@ -179,7 +206,9 @@ func Stats(c *websocket.Conn) {
 
 			msg, _ = json.Marshal(r)
 			c.WriteMessage(websocket.TextMessage, []byte(msg))
-			time.Sleep(time.Second)
+			if !server.Sleep(ctx, time.Second) {
+				return
+			}
 		}
 	}
 
@@ -190,7 +219,7 @@ func Stats(c *websocket.Conn) {
 
 	for {
 
-		node, err := service.Get(nameSpacedName)
+		node, err := service.Get(ctx, nameSpacedName)
 
 		if err != nil {
 			c.WriteJSON(err)
@@ -202,7 +231,7 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		client := jsonrpc.NewClient(fmt.Sprintf("http://%s.%s:%d", nameSpacedName.Name, nameSpacedName.Namespace, node.Spec.RPCPort))
+		client := jsonrpc.NewClientWithOpts(fmt.Sprintf("http://%s.%s:%d", nameSpacedName.Name, nameSpacedName.Namespace, node.Spec.RPCPort), &jsonrpc.RPCClientOpts{HTTPClient: tracing.HTTPClient(ctx, 0)})
 
 		type SyncStatus struct {
 			CurrentBlock string `json:"currentBlock"`
@@ -235,7 +264,9 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		time.Sleep(time.Second)
+		if !server.Sleep(ctx, time.Second) {
+			return
+		}
 	}
 }
 
@@ -249,7 +280,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
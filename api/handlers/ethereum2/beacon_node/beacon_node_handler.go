@@ -10,7 +10,10 @@ import (
 	"github.com/kotalco/community-api/internal/ethereum2/beacon_node"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	"io/ioutil"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -36,8 +39,9 @@ const (
 )
 
 var (
-	service   = beacon_node.NewBeaconNodeService()
-	k8sClient = k8s.NewClientService()
+	service            = beacon_node.NewBeaconNodeService()
+	k8sClient          = k8s.NewClientService()
+	statefulSetService = statefulset.NewService()
 )
 
 // Get gets a single ethereum 2.0 beacon node by name
@@ -46,7 +50,14 @@ var (
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(ethereum2v1alpha1.BeaconNode)
 
-	return c.JSON(shared.NewResponse(new(beacon_node.BeaconNodeDto).FromEthereum2BeaconNode(node)))
+	dto := new(beacon_node.BeaconNodeDto).FromEthereum2BeaconNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.JSON(shared.NewResponse(dto))
 }
 
 // List returns all ethereum 2.0 beacon nodes
@@ -58,7 +69,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodes, err := service.List(c.Locals("namespace").(string))
+	nodes, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -71,7 +82,16 @@ func List(c *fiber.Ctx) error {
 		return nodes.Items[j].CreationTimestamp.Before(&nodes.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(beacon_node.BeaconNodeListDto).FromEthereum2BeaconNode(nodes.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(beacon_node.BeaconNodeListDto).FromEthereum2BeaconNode(nodes.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates ethereum 2.0 beacon node from spec
@@ -92,7 +112,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -107,7 +127,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(ethereum2v1alpha1.BeaconNode)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -128,9 +148,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	beaconnode := c.Locals("node").(ethereum2v1alpha1.BeaconNode)
 
-	err := service.Update(*dto, &beaconnode)
+	err := service.Update(c.UserContext(), *dto, &beaconnode)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -143,7 +167,7 @@ func Update(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -164,7 +188,7 @@ func ValidateBeaconNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -176,6 +200,7 @@ func ValidateBeaconNodeExist(c *fiber.Ctx) error {
 // Stats returns a websocket that emits peer  count and node syncing status
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	name := c.Params("name")
 	beaconnode := &ethereum2v1alpha1.BeaconNode{}
@@ -183,7 +208,7 @@ func Stats(c *websocket.Conn) {
 		Namespace: c.Locals("namespace").(string),
 		Name:      name,
 	}
-	err := k8sClient.Get(context.Background(), nameSpacedName, beaconnode)
+	err := k8sClient.Get(ctx, nameSpacedName, beaconnode)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			c.WriteJSON(fiber.Map{
@@ -223,7 +248,7 @@ func Stats(c *websocket.Conn) {
 		results := make(chan result, 2)
 
 		for i := 0; i < 2; i++ {
-			go worker(jobs, results)
+			go worker(ctx, jobs, results)
 		}
 
 		jobs <- request{name: "peers", url: fmt.Sprintf("%speer_count", baseUrl)}
@@ -291,34 +316,37 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		time.Sleep(time.Second * 3)
+		if !server.Sleep(ctx, time.Second*3) {
+			return
+		}
 	}
 }
 
 // worker is a  collection of threads for the beacon node stats
-func worker(jobs <-chan request, results chan<- result) {
-	chanRes := result{}
+func worker(ctx context.Context, jobs <-chan request, results chan<- result) {
 	for job := range jobs {
-		chanRes.name = job.name
+		chanRes := result{name: job.name}
 
-		client := http.Client{
-			Timeout: 4 * time.Second,
-		}
-		req, err := http.NewRequest(http.MethodGet, job.url, bytes.NewReader([]byte(nil)))
+		client := tracing.HTTPClient(ctx, 4*time.Second)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, job.url, bytes.NewReader([]byte(nil)))
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 		resp, err := client.Do(req)
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 
 		responseData, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 		chanRes.data = responseData
 		results <- chanRes
@@ -5,6 +5,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/kotalco/community-api/internal/ethereum2/validator"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
 	"github.com/kotalco/community-api/pkg/shared"
 	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,7 +18,10 @@ const (
 	nameKeyword = "name"
 )
 
-var service = validator.NewValidatorService()
+var (
+	service            = validator.NewValidatorService()
+	statefulSetService = statefulset.NewService()
+)
 
 // Get gets a single Ethereum 2.0 validator client by name
 // 1-get the node validated from ValidateNodeExist method
@@ -25,7 +29,14 @@ var service = validator.NewValidatorService()
 func Get(c *fiber.Ctx) error {
 	validatorNode := c.Locals("validator").(ethereum2v1alpha1.Validator)
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(validator.ValidatorDto).FromEthereum2Validator(validatorNode)))
+	dto := new(validator.ValidatorDto).FromEthereum2Validator(validatorNode)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: validatorNode.Namespace, Name: validatorNode.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
 }
 
 // List returns all Ethereum 2.0 validator clients
@@ -37,7 +48,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	validatorList, err := service.List(c.Locals("namespace").(string))
+	validatorList, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -50,7 +61,16 @@ func List(c *fiber.Ctx) error {
 		return validatorList.Items[j].CreationTimestamp.Before(&validatorList.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(validator.ValidatorListDto).FromEthereum2Validator(validatorList.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(validator.ValidatorListDto).FromEthereum2Validator(validatorList.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates Ethereum 2.0 validator client from spec
@@ -72,7 +92,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	validatorNode, err := service.Create(*dto)
+	validatorNode, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -87,7 +107,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	validatorNode := c.Locals("validator").(ethereum2v1alpha1.Validator)
 
-	err := service.Delete(&validatorNode)
+	err := service.Delete(c.UserContext(), &validatorNode)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -108,9 +128,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	validatorNode := c.Locals("validator").(ethereum2v1alpha1.Validator)
 
-	err := service.Update(*dto, &validatorNode)
+	err := service.Update(c.UserContext(), *dto, &validatorNode)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -123,7 +147,7 @@ func Update(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -144,7 +168,7 @@ func ValidateValidatorExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	validatorNode, err := service.Get(nameSpacedName)
+	validatorNode, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
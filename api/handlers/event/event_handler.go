@@ -0,0 +1,67 @@
+// Package event handler is the representation layer for the event domain
+// returns the kubernetes events explaining why a resource isn't running
+package event
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"github.com/kotalco/community-api/internal/event"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/server"
+	"github.com/kotalco/community-api/pkg/shared"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nameKeyword = "name"
+)
+
+var service = event.NewEventService()
+
+// List returns the handler listing the events of a resource of kind gvk
+// 1-call service to list the events of the resource, its statefulset, pods, volume and service
+// 2-format the de-duplicated events sorted by the time they were last seen using NewResponse
+func List(gvk schema.GroupVersionKind) fiber.Handler {
+	kind := kindFor(gvk)
+
+	return func(c *fiber.Ctx) error {
+		name := types.NamespacedName{Namespace: c.Locals("namespace").(string), Name: c.Params(nameKeyword)}
+		dtos, err := service.List(c.UserContext(), kind, name)
+		if err != nil {
+			return c.Status(err.StatusCode()).JSON(err)
+		}
+
+		return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
+	}
+}
+
+// Stream returns a websocket that emits the events of a resource of kind gvk
+// the current events are emitted first, then every event recorded or updated after them
+func Stream(gvk schema.GroupVersionKind) func(*websocket.Conn) {
+	kind := kindFor(gvk)
+
+	return func(c *websocket.Conn) {
+		defer c.Close()
+		ctx := server.StreamContext(c)
+
+		name := types.NamespacedName{Namespace: c.Locals("namespace").(string), Name: c.Params(nameKeyword)}
+		err := service.Stream(ctx, kind, name, func(dto k8s.EventDto) error {
+			return c.WriteJSON(shared.NewResponse(dto))
+		})
+		if err != nil {
+			c.WriteJSON(shared.NewResponse(err))
+		}
+	}
+}
+
+func kindFor(gvk schema.GroupVersionKind) k8s.Kind {
+	kind, ok := k8s.KindFor(gvk)
+	if !ok {
+		panic(fmt.Sprintf("can't list events of unknown kind %s", gvk))
+	}
+	return kind
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/kotalco/community-api/internal/filecoin"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
 	"github.com/kotalco/community-api/pkg/shared"
 	filecoinv1alpha1 "github.com/kotalco/kotal/apis/filecoin/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,7 +18,10 @@ const (
 	nameKeyword = "name"
 )
 
-var service = filecoin.NewFilecoinService()
+var (
+	service            = filecoin.NewFilecoinService()
+	statefulSetService = statefulset.NewService()
+)
 
 // Get gets a single Filecoin node by name
 // 1-get the node validated from ValidateNodeExist method
@@ -25,7 +29,14 @@ var service = filecoin.NewFilecoinService()
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(filecoinv1alpha1.Node)
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(filecoin.FilecoinDto).FromFilecoinNode(node)))
+	dto := new(filecoin.FilecoinDto).FromFilecoinNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
 }
 
 // List returns all Filecoin nodes
@@ -37,7 +48,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodes, err := service.List(c.Locals("namespace").(string))
+	nodes, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -50,7 +61,16 @@ func List(c *fiber.Ctx) error {
 		return nodes.Items[j].CreationTimestamp.Before(&nodes.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(filecoin.FilecoinListDto).FromFilecoinNode(nodes.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(filecoin.FilecoinListDto).FromFilecoinNode(nodes.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates Filecoin node from spec
@@ -72,7 +92,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -87,7 +107,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(filecoinv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -107,9 +127,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(filecoinv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -122,7 +146,7 @@ func Update(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -143,7 +167,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
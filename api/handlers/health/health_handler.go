@@ -0,0 +1,22 @@
+// Package health handler is the representation layer for the api server readiness
+package health
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/health"
+	"github.com/kotalco/community-api/pkg/shared"
+	"net/http"
+)
+
+var service = health.NewHealthService()
+
+// Readiness returns 200 if the api server is ready to serve requests, 503 otherwise
+// the response details the state of every dependency including optional ones like metrics
+func Readiness(c *fiber.Ctx) error {
+	readiness := service.Readiness()
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	return c.Status(status).JSON(shared.NewResponse(readiness))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/kotalco/community-api/internal/ipfs/ipfs_cluster_peer"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
 	"github.com/kotalco/community-api/pkg/shared"
 	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,7 +20,10 @@ const (
 	nameKeyword = "name"
 )
 
-var service = ipfs_cluster_peer.NewIpfsClusterPeerService()
+var (
+	service            = ipfs_cluster_peer.NewIpfsClusterPeerService()
+	statefulSetService = statefulset.NewService()
+)
 
 // Get gets a single IPFS cluster peer by name
 // 1-get the node validated from ValidateClusterPeerExist method
@@ -27,7 +31,14 @@ var service = ipfs_cluster_peer.NewIpfsClusterPeerService()
 func Get(c *fiber.Ctx) error {
 	peer := c.Locals("peer").(ipfsv1alpha1.ClusterPeer)
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(ipfs_cluster_peer.ClusterPeerDto).FromIPFSClusterPeer(peer)))
+	dto := new(ipfs_cluster_peer.ClusterPeerDto).FromIPFSClusterPeer(peer)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: peer.Namespace, Name: peer.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
 }
 
 // List returns all IPFS cluster peers
@@ -39,7 +50,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	peers, err := service.List(c.Locals("namespace").(string))
+	peers, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -52,7 +63,16 @@ func List(c *fiber.Ctx) error {
 		return peers.Items[j].CreationTimestamp.Before(&peers.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(ipfs_cluster_peer.ClusterPeerListDto).FromIPFSClusterPeer(peers.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(ipfs_cluster_peer.ClusterPeerListDto).FromIPFSClusterPeer(peers.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates IPFS cluster peer from spec
@@ -73,7 +93,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	peer, err := service.Create(*dto)
+	peer, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -88,7 +108,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	peer := c.Locals("peer").(ipfsv1alpha1.ClusterPeer)
 
-	err := service.Delete(&peer)
+	err := service.Delete(c.UserContext(), &peer)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -109,9 +129,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	peer := c.Locals("peer").(ipfsv1alpha1.ClusterPeer)
 
-	err := service.Update(*dto, &peer)
+	err := service.Update(c.UserContext(), *dto, &peer)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -124,7 +148,7 @@ func Update(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -145,7 +169,7 @@ func ValidateClusterPeerExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	peer, err := service.Get(nameSpacedName)
+	peer, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -12,7 +12,10 @@ import (
 	"github.com/kotalco/community-api/internal/ipfs/ipfs_peer"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
 	"io/ioutil"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -38,8 +41,9 @@ const (
 )
 
 var (
-	service   = ipfs_peer.NewIpfsPeerService()
-	k8sClient = k8s.NewClientService()
+	service            = ipfs_peer.NewIpfsPeerService()
+	k8sClient          = k8s.NewClientService()
+	statefulSetService = statefulset.NewService()
 )
 
 // Get gets a single IPFS peer by name
@@ -48,7 +52,14 @@ var (
 func Get(c *fiber.Ctx) error {
 	peer := c.Locals("peer").(ipfsv1alpha1.Peer)
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(ipfs_peer.PeerDto).FromIPFSPeer(peer)))
+	dto := new(ipfs_peer.PeerDto).FromIPFSPeer(peer)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: peer.Namespace, Name: peer.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
 }
 
 // List returns all IPFS peers
@@ -60,7 +71,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	peers, err := service.List(c.Locals("namespace").(string))
+	peers, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -73,7 +84,16 @@ func List(c *fiber.Ctx) error {
 		return peers.Items[j].CreationTimestamp.Before(&peers.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(ipfs_peer.PeerListDto).FromIPFSPeer(peers.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(ipfs_peer.PeerListDto).FromIPFSPeer(peers.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates IPFS peer from spec
@@ -95,7 +115,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	peer, err := service.Create(*dto)
+	peer, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -110,7 +130,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	peer := c.Locals("peer").(ipfsv1alpha1.Peer)
 
-	err := service.Delete(&peer)
+	err := service.Delete(c.UserContext(), &peer)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -130,9 +150,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	peer := c.Locals("peer").(ipfsv1alpha1.Peer)
 
-	err := service.Update(*dto, &peer)
+	err := service.Update(c.UserContext(), *dto, &peer)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -145,7 +169,7 @@ func Update(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -166,7 +190,7 @@ func ValidatePeerExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	peer, err := service.Get(nameSpacedName)
+	peer, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -179,6 +203,7 @@ func ValidatePeerExist(c *fiber.Ctx) error {
 // Stats returns a websocket that emits peers,pin and files stats
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	name := c.Params("name")
 	peer := &ipfsv1alpha1.Peer{}
@@ -186,7 +211,7 @@ func Stats(c *websocket.Conn) {
 		Namespace: c.Locals("namespace").(string),
 		Name:      name,
 	}
-	err := k8sClient.Get(context.Background(), nameSpacedName, peer)
+	err := k8sClient.Get(ctx, nameSpacedName, peer)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			c.WriteJSON(fiber.Map{
@@ -212,7 +237,7 @@ func Stats(c *websocket.Conn) {
 		results := make(chan result, 3)
 
 		for i := 0; i < 3; i++ {
-			go worker(jobs, results)
+			go worker(ctx, jobs, results)
 		}
 
 		baseUrl := fmt.Sprintf("http://%s.%s:%d/api/v0", peer.Name, nameSpacedName.Namespace, peer.Spec.APIPort)
@@ -288,34 +313,37 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		time.Sleep(time.Second * 3)
+		if !server.Sleep(ctx, time.Second*3) {
+			return
+		}
 	}
 }
 
 // worker is a  collection of threads for the ipfs peer stats
-func worker(jobs <-chan request, results chan<- result) {
-	chanRes := result{}
+func worker(ctx context.Context, jobs <-chan request, results chan<- result) {
 	for job := range jobs {
-		chanRes.name = job.name
+		chanRes := result{name: job.name}
 
-		client := http.Client{
-			Timeout: 4 * time.Second,
-		}
-		req, err := http.NewRequest(http.MethodPost, job.url, bytes.NewReader([]byte(nil)))
+		client := tracing.HTTPClient(ctx, 4*time.Second)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.url, bytes.NewReader([]byte(nil)))
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 		resp, err := client.Do(req)
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 
 		responseData, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
 		if err != nil {
 			chanRes.err = err
-			return
+			results <- chanRes
+			continue
 		}
 		chanRes.data = responseData
 		results <- chanRes
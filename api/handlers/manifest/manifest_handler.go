@@ -0,0 +1,144 @@
+// Package manifest handler is the representation layer for the manifest domain
+// exports resources as yaml or json manifests and imports them back
+package manifest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/manifest"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/shared"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nameKeyword             = "name"
+	formatYAML              = "yaml"
+	formatJSON              = "json"
+	yamlContentType         = "application/yaml"
+	referencedSecretsHeader = "X-Referenced-Secrets"
+)
+
+var service = manifest.NewManifestService()
+
+// encoder is a manifest or an export that can be encoded in both formats
+type encoder interface {
+	YAML() ([]byte, error)
+	JSON() ([]byte, error)
+}
+
+// Get returns the handler exporting the manifest of a resource of kind gvk
+// 1-validate the format query string, yaml by default
+// 2-call service to get the clean manifest of the resource
+// 3-encode the manifest and list the referenced secrets in the X-Referenced-Secrets header
+func Get(gvk schema.GroupVersionKind) fiber.Handler {
+	kind, ok := k8s.KindFor(gvk)
+	if !ok {
+		panic(fmt.Sprintf("can't export manifests of unknown kind %s", gvk))
+	}
+
+	return func(c *fiber.Ctx) error {
+		format, err := parseFormat(c)
+		if err != nil {
+			return c.Status(err.StatusCode()).JSON(err)
+		}
+
+		name := types.NamespacedName{Namespace: c.Locals("namespace").(string), Name: c.Params(nameKeyword)}
+		dto, err := service.Get(c.UserContext(), kind, name)
+		if err != nil {
+			return c.Status(err.StatusCode()).JSON(err)
+		}
+
+		return send(c, format, dto, dto.Secrets)
+	}
+}
+
+// Export returns the manifests of all resources in the namespace, or of the members of the project query string
+// 1-validate the format query string, yaml by default
+// 2-call service to get the clean manifests of the resources
+// 3-encode the manifests as an attachment and list the referenced secrets in the X-Referenced-Secrets header
+func Export(c *fiber.Ctx) error {
+	format, err := parseFormat(c)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	namespace := c.Locals("namespace").(string)
+	project := c.Query("project")
+	dto, err := service.Export(c.UserContext(), namespace, project)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	filename := namespace
+	if project != "" {
+		filename = project
+	}
+	c.Attachment(fmt.Sprintf("%s.%s", filename, format))
+
+	return send(c, format, dto, dto.Secrets)
+}
+
+// Import validates the kotal manifests of the yaml or json request body, and applies them if the apply query string is true
+// 1-validate the apply and conflict query strings, conflict defaults to fail
+// 2-call service to validate the documents then apply them if all of them are valid
+// 3-format the result of every document using NewResponse, with 422 if any document has errors
+func Import(c *fiber.Ctx) error {
+	apply := false
+	if c.Query("apply") != "" {
+		var parseErr error
+		if apply, parseErr = strconv.ParseBool(c.Query("apply")); parseErr != nil {
+			badReq := restErrors.NewBadRequestError("apply must be true or false")
+			return c.Status(badReq.StatusCode()).JSON(badReq)
+		}
+	}
+	conflict := c.Query("conflict", manifest.ConflictFail)
+	if conflict != manifest.ConflictSkip && conflict != manifest.ConflictOverwrite && conflict != manifest.ConflictFail {
+		badReq := restErrors.NewBadRequestError(fmt.Sprintf("conflict must be %s, %s or %s", manifest.ConflictSkip, manifest.ConflictOverwrite, manifest.ConflictFail))
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+
+	opts := manifest.ImportOptions{Namespace: c.Locals("namespace").(string), Apply: apply, Conflict: conflict}
+	dto, err := service.Import(c.UserContext(), c.Body(), opts)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	status := http.StatusOK
+	if dto.Failed() {
+		status = http.StatusUnprocessableEntity
+	}
+	return c.Status(status).JSON(shared.NewResponse(dto))
+}
+
+func parseFormat(c *fiber.Ctx) (string, restErrors.IRestErr) {
+	format := c.Query("format", formatYAML)
+	if format != formatYAML && format != formatJSON {
+		return "", restErrors.NewBadRequestError(fmt.Sprintf("format must be %s or %s", formatYAML, formatJSON))
+	}
+	return format, nil
+}
+
+func send(c *fiber.Ctx, format string, dto encoder, secrets []string) error {
+	encode, contentType := dto.YAML, yamlContentType
+	if format == formatJSON {
+		encode, contentType = dto.JSON, fiber.MIMEApplicationJSON
+	}
+
+	body, err := encode()
+	if err != nil {
+		internalErr := restErrors.NewInternalServerError("can't encode manifests")
+		return c.Status(internalErr.StatusCode()).JSON(internalErr)
+	}
+
+	c.Set("Access-Control-Expose-Headers", fmt.Sprintf("%s, %s", referencedSecretsHeader, fiber.HeaderContentDisposition))
+	c.Set(referencedSecretsHeader, strings.Join(secrets, ","))
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Status(http.StatusOK).Send(body)
+}
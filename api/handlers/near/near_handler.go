@@ -1,7 +1,6 @@
 package near
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
@@ -9,7 +8,11 @@ import (
 	"github.com/kotalco/community-api/internal/near"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	nearv1alpha1 "github.com/kotalco/kotal/apis/near/v1alpha1"
 	"github.com/ybbus/jsonrpc/v2"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -28,6 +31,9 @@ const (
 var (
 	k8sClient = k8s.NewClientService()
 	service   = near.NewNearService()
+	// statsLogger logs the stats poller rpc errors, its logs are sampled as they're repeated every poll
+	statsLogger        = logger.Component(logger.Stats)
+	statefulSetService = statefulset.NewService()
 )
 
 // Get gets a single NEAR node by name
@@ -36,7 +42,14 @@ var (
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(nearv1alpha1.Node)
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(near.NearDto).FromNEARNode(node)))
+	dto := new(near.NearDto).FromNEARNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
 }
 
 // List returns all NEAR nodes
@@ -48,7 +61,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodes, err := service.List(c.Locals("namespace").(string))
+	nodes, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -61,7 +74,16 @@ func List(c *fiber.Ctx) error {
 		return nodes.Items[j].CreationTimestamp.Before(&nodes.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(near.NearListDto).FromNEARNode(nodes.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(near.NearListDto).FromNEARNode(nodes.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates NEAR node from spec
@@ -84,7 +106,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -99,7 +121,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(nearv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -119,9 +141,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(nearv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -134,7 +160,7 @@ func Update(c *fiber.Ctx) error {
 // 2-create X-Total-Count header with the length
 // 3-return
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -147,6 +173,7 @@ func Count(c *fiber.Ctx) error {
 
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	type Result struct {
 		Error string `json:"error,omitempty"`
@@ -195,7 +222,9 @@ func Stats(c *websocket.Conn) {
 			if err != nil {
 				return
 			}
-			time.Sleep(time.Second)
+			if !server.Sleep(ctx, time.Second) {
+				return
+			}
 		}
 	}
 
@@ -208,7 +237,7 @@ func Stats(c *websocket.Conn) {
 
 	for {
 
-		err := k8sClient.Get(context.Background(), nameSpacedName, node)
+		err := k8sClient.Get(ctx, nameSpacedName, node)
 		if errors.IsNotFound(err) {
 			c.WriteJSON(fiber.Map{
 				"error": fmt.Sprintf("node by name %s doesn't exist", name),
@@ -220,11 +249,13 @@ func Stats(c *websocket.Conn) {
 			c.WriteJSON(fiber.Map{
 				"error": "JSON-RPC server is not enabled",
 			})
-			time.Sleep(time.Second)
+			if !server.Sleep(ctx, time.Second) {
+				return
+			}
 			continue
 		}
 
-		client := jsonrpc.NewClient(fmt.Sprintf("http://%s.%s:%d", nameSpacedName.Name, nameSpacedName.Namespace, node.Spec.RPCPort))
+		client := jsonrpc.NewClientWithOpts(fmt.Sprintf("http://%s.%s:%d", nameSpacedName.Name, nameSpacedName.Namespace, node.Spec.RPCPort), &jsonrpc.RPCClientOpts{HTTPClient: tracing.HTTPClient(ctx, 0)})
 
 		type NodeStatus struct {
 			SyncInfo struct {
@@ -238,7 +269,7 @@ func Stats(c *websocket.Conn) {
 		nodeStatus := &NodeStatus{}
 		err = client.CallFor(nodeStatus, "status")
 		if err != nil {
-			fmt.Println(err)
+			statsLogger.WarnContext(ctx, "NEAR_STATS_STATUS", err)
 		}
 
 		type NetworkInfo struct {
@@ -252,7 +283,7 @@ func Stats(c *websocket.Conn) {
 		networkInfo := &NetworkInfo{}
 		err = client.CallFor(networkInfo, "network_info")
 		if err != nil {
-			fmt.Println(err)
+			statsLogger.WarnContext(ctx, "NEAR_STATS_NETWORK_INFO", err)
 		}
 
 		err = c.WriteJSON(fiber.Map{
@@ -268,7 +299,9 @@ func Stats(c *websocket.Conn) {
 			return
 		}
 
-		time.Sleep(time.Second)
+		if !server.Sleep(ctx, time.Second) {
+			return
+		}
 	}
 }
 
@@ -282,7 +315,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -0,0 +1,25 @@
+// Package overview handler is the representation layer for the overview domain
+// aggregates the resources of every protocol in the namespace for the dashboard
+package overview
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/overview"
+	"github.com/kotalco/community-api/pkg/shared"
+)
+
+var service = overview.NewOverviewService()
+
+// Get returns the counts by state and requested resources of every protocol and the unhealthy resources
+// 1-call service to aggregate the namespace resources
+// 2-format the response using NewResponse
+func Get(c *fiber.Ctx) error {
+	dto, err := service.Get(c.UserContext(), c.Locals("namespace").(string))
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
+}
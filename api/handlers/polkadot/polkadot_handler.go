@@ -1,7 +1,6 @@
 package polkadot
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,7 +14,10 @@ import (
 	"github.com/kotalco/community-api/internal/polkadot"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
+	"github.com/kotalco/community-api/pkg/tracing"
 	polkadotv1alpha1 "github.com/kotalco/kotal/apis/polkadot/v1alpha1"
 	"github.com/ybbus/jsonrpc/v2"
 	corev1 "k8s.io/api/core/v1"
@@ -29,15 +31,23 @@ const (
 )
 
 var (
-	k8sClient = k8s.NewClientService()
-	service   = polkadot.NewPolkadotService()
+	k8sClient          = k8s.NewClientService()
+	service            = polkadot.NewPolkadotService()
+	statefulSetService = statefulset.NewService()
 )
 
 // Get gets a single Polkadot node by name
 func Get(c *fiber.Ctx) error {
 	node := c.Locals("node").(polkadotv1alpha1.Node)
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(polkadot.PolkadotDto).FromPolkadotNode(node)))
+	dto := new(polkadot.PolkadotDto).FromPolkadotNode(node)
+	status, err := statefulSetService.Status(c.UserContext(), types.NamespacedName{Namespace: node.Namespace, Name: node.Name})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	dto.SetStatus(status)
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
 }
 
 // List returns all Polkadot nodes
@@ -45,7 +55,7 @@ func List(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page"))
 	limit, _ := strconv.Atoi(c.Query("limit"))
 
-	nodes, err := service.List(c.Locals("namespace").(string))
+	nodes, err := service.List(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -58,7 +68,16 @@ func List(c *fiber.Ctx) error {
 		return nodes.Items[j].CreationTimestamp.Before(&nodes.Items[i].CreationTimestamp)
 	})
 
-	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(polkadot.PolkadotListDto).FromPolkadotNode(nodes.Items[start:end])))
+	statuses, statusesErr := statefulSetService.Statuses(c.UserContext(), c.Locals("namespace").(string))
+	if statusesErr != nil {
+		return c.Status(statusesErr.StatusCode()).JSON(statusesErr)
+	}
+	dtos := new(polkadot.PolkadotListDto).FromPolkadotNode(nodes.Items[start:end])
+	for i := range dtos {
+		dtos[i].SetStatus(statuses.Of(dtos[i].Name))
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dtos))
 }
 
 // Create creates Polkadot node from spec
@@ -77,7 +96,7 @@ func Create(c *fiber.Ctx) error {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
 
-	node, err := service.Create(*dto)
+	node, err := service.Create(c.UserContext(), *dto)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -89,7 +108,7 @@ func Create(c *fiber.Ctx) error {
 func Delete(c *fiber.Ctx) error {
 	node := c.Locals("node").(polkadotv1alpha1.Node)
 
-	err := service.Delete(&node)
+	err := service.Delete(c.UserContext(), &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -105,9 +124,13 @@ func Update(c *fiber.Ctx) error {
 		return c.Status(badReq.StatusCode()).JSON(badReq)
 	}
 
+	if err := dto.MetaDataDto.ValidateLabelsAndAnnotations(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
 	node := c.Locals("node").(polkadotv1alpha1.Node)
 
-	err := service.Update(*dto, &node)
+	err := service.Update(c.UserContext(), *dto, &node)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -117,7 +140,7 @@ func Update(c *fiber.Ctx) error {
 
 // Count returns total number of nodes
 func Count(c *fiber.Ctx) error {
-	length, err := service.Count(c.Locals("namespace").(string))
+	length, err := service.Count(c.UserContext(), c.Locals("namespace").(string))
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -130,6 +153,7 @@ func Count(c *fiber.Ctx) error {
 
 func Stats(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	type Result struct {
 		Error string `json:"error,omitempty"`
@@ -167,7 +191,9 @@ func Stats(c *websocket.Conn) {
 
 			msg, _ = json.Marshal(r)
 			c.WriteMessage(websocket.TextMessage, []byte(msg))
-			time.Sleep(time.Second)
+			if !server.Sleep(ctx, time.Second) {
+				return
+			}
 		}
 	}
 
@@ -187,7 +213,7 @@ nodeCheck:
 	// because node can be deleted, and rpc closed
 	// during the lifetime of socket connection
 	node := &polkadotv1alpha1.Node{}
-	if err := k8sClient.Get(context.Background(), nodeKey, node); errors.IsNotFound(err) {
+	if err := k8sClient.Get(ctx, nodeKey, node); errors.IsNotFound(err) {
 		c.WriteJSON(fiber.Map{
 			"error": fmt.Sprintf("node by name %s doesn't exist", name),
 		})
@@ -198,7 +224,9 @@ nodeCheck:
 		if err := c.WriteJSON(fiber.Map{"error": "JSON-RPC server is not enabled"}); err != nil {
 			return
 		}
-		time.Sleep(3 * time.Second)
+		if !server.Sleep(ctx, 3*time.Second) {
+			return
+		}
 		goto nodeCheck
 	}
 
@@ -206,18 +234,20 @@ podCheck:
 	// check pod exist if any rpc failed
 	// if pod is not found, check if node has been deleted
 	pod := &corev1.Pod{}
-	if err := k8sClient.Get(context.Background(), podKey, pod); err != nil {
+	if err := k8sClient.Get(ctx, podKey, pod); err != nil {
 		if apierrors.IsNotFound(err) {
 			goto nodeCheck
 		}
 	}
 	if pod.Status.Phase != corev1.PodRunning {
-		time.Sleep(3 * time.Second)
+		if !server.Sleep(ctx, 3*time.Second) {
+			return
+		}
 		goto podCheck
 	}
 
 	endpoint := fmt.Sprintf("http://%s.%s:%d", node.Name, node.Namespace, node.Spec.RPCPort)
-	rpcClient := jsonrpc.NewClient(endpoint)
+	rpcClient := jsonrpc.NewClientWithOpts(endpoint, &jsonrpc.RPCClientOpts{HTTPClient: tracing.HTTPClient(ctx, 0)})
 
 	for {
 
@@ -229,7 +259,9 @@ podCheck:
 		// sync state rpc call
 		syncState := &SyncState{}
 		if err := rpcClient.CallFor(syncState, "system_syncState"); err != nil {
-			time.Sleep(3 * time.Second)
+			if !server.Sleep(ctx, 3*time.Second) {
+				return
+			}
 			goto podCheck
 		}
 
@@ -242,7 +274,9 @@ podCheck:
 		// system health rpc call
 		systemHealth := &SystemHealth{}
 		if err := rpcClient.CallFor(systemHealth, "system_health"); err != nil {
-			time.Sleep(3 * time.Second)
+			if !server.Sleep(ctx, 3*time.Second) {
+				return
+			}
 			goto podCheck
 		}
 
@@ -255,7 +289,9 @@ podCheck:
 			return
 		}
 
-		time.Sleep(time.Second)
+		if !server.Sleep(ctx, time.Second) {
+			return
+		}
 	}
 }
 
@@ -266,7 +302,7 @@ func ValidateNodeExist(c *fiber.Ctx) error {
 		Namespace: c.Locals("namespace").(string),
 	}
 
-	node, err := service.Get(nameSpacedName)
+	node, err := service.Get(c.UserContext(), nameSpacedName)
 	if err != nil {
 		return c.Status(err.StatusCode()).JSON(err)
 	}
@@ -0,0 +1,161 @@
+// Package project handler is the representation layer for the project domain
+// groups resources of any protocol in projects
+package project
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/project"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	nameKeyword = "name"
+)
+
+var service = project.NewProjectService()
+
+// Get gets a single project with its members
+// 1-get the project validated from ValidateProjectExist method
+// 2-call service to list the project members with their state
+// 3-marshall project and members to dto and format the response
+func Get(c *fiber.Ctx) error {
+	projectModel := c.Locals("project").(corev1.ConfigMap)
+
+	members, err := service.Members(c.UserContext(), &projectModel)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	dto := new(project.ProjectDto).FromConfigMap(projectModel)
+	dto.Members = members
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
+}
+
+// List returns all projects
+// 1-get the pagination qs default to 0
+// 2-call service to return project models
+// 3-paginate the list
+// 4-marshall projects to projects dto and format the response using NewResponse
+func List(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	projects, err := service.List(c.UserContext(), c.Locals("namespace").(string))
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	c.Set("Access-Control-Expose-Headers", "X-Total-Count")
+	c.Set("X-Total-Count", fmt.Sprintf("%d", len(projects.Items)))
+
+	start, end := shared.Page(uint(len(projects.Items)), uint(page), uint(limit))
+	sort.Slice(projects.Items[:], func(i, j int) bool {
+		return projects.Items[j].CreationTimestamp.Before(&projects.Items[i].CreationTimestamp)
+	})
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(new(project.ProjectListDto).FromConfigMap(projects.Items[start:end])))
+}
+
+// Create creates a project
+// 1-validate the project name
+// 2-call service to create the project
+// 3-marshall the project to dto and format the response
+func Create(c *fiber.Ctx) error {
+	dto := new(project.ProjectDto)
+	if err := c.BodyParser(dto); err != nil {
+		badReq := restErrors.NewBadRequestError("invalid request body")
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+
+	dto.Namespace = c.Locals("namespace").(string)
+
+	if err := dto.MetaDataDto.Validate(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	projectModel, err := service.Create(c.UserContext(), *dto)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(shared.NewResponse(new(project.ProjectDto).FromConfigMap(projectModel)))
+}
+
+// Delete deletes a project
+// members are deleted with the project if the cascade query is true, and detached otherwise
+func Delete(c *fiber.Ctx) error {
+	projectModel := c.Locals("project").(corev1.ConfigMap)
+
+	cascade, _ := strconv.ParseBool(c.Query("cascade")) // default cascade to false
+
+	err := service.Delete(c.UserContext(), &projectModel, cascade)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// Attach adds a resource to the project
+// the body identifies the resource by its protocol and resource url groups and its name, secrets are core secrets
+func Attach(c *fiber.Ctx) error {
+	projectModel := c.Locals("project").(corev1.ConfigMap)
+
+	dto := new(project.MemberDto)
+	if err := c.BodyParser(dto); err != nil || dto.Protocol == "" || dto.Resource == "" || dto.Name == "" {
+		badReq := restErrors.NewBadRequestError("request body must have protocol, resource and name")
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+
+	member, err := service.Attach(c.UserContext(), &projectModel, *dto)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(member))
+}
+
+// Detach removes a resource from the project without deleting it
+func Detach(c *fiber.Ctx) error {
+	projectModel := c.Locals("project").(corev1.ConfigMap)
+
+	err := service.Detach(c.UserContext(), &projectModel, project.MemberDto{
+		Protocol: c.Params("protocol"),
+		Resource: c.Params("resource"),
+		Name:     c.Params("member"),
+	})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// ValidateProjectExist validate project by name exist acts as a validation for all handlers the needs to find project by name
+// 1-call project service to check if project exits
+// 2-return 404 if it's not
+// 3-save the project to local with the key project to be used by the other handlers
+func ValidateProjectExist(c *fiber.Ctx) error {
+	nameSpacedName := types.NamespacedName{
+		Name:      c.Params(nameKeyword),
+		Namespace: c.Locals("namespace").(string),
+	}
+
+	projectModel, err := service.Get(c.UserContext(), nameSpacedName)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	c.Locals("project", projectModel)
+
+	return c.Next()
+}
@@ -0,0 +1,45 @@
+// Package search handler is the representation layer for the search domain
+// searches resources of every protocol in the namespace
+package search
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/search"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/shared"
+)
+
+const maxQueryLength = 128
+
+var service = search.NewSearchService()
+
+// Search returns the ranked resources matching the q query string
+// 1-validate the q query string and get the pagination qs
+// 2-call service to search every kotal kind
+// 3-paginate the hits and format the response using NewResponse
+func Search(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" || len(query) > maxQueryLength {
+		badReq := restErrors.NewBadRequestError(fmt.Sprintf("q query must be between 1 and %d characters", maxQueryLength))
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+	page, _ := strconv.Atoi(c.Query("page")) // default page to 0
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	hits, err := service.Search(c.UserContext(), c.Locals("namespace").(string), query)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	start, end := shared.Page(uint(len(hits)), uint(page), uint(limit))
+
+	c.Set("Access-Control-Expose-Headers", "X-Total-Count")
+	c.Set("X-Total-Count", fmt.Sprintf("%d", len(hits)))
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(hits[start:end]))
+}
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/search"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// searchServiceMock returns hits named after their rank
+type searchServiceMock struct {
+	hits      int
+	namespace string
+	query     string
+}
+
+func (mock *searchServiceMock) Search(_ context.Context, namespace string, query string) ([]search.HitDto, restErrors.IRestErr) {
+	mock.namespace, mock.query = namespace, query
+	hits := make([]search.HitDto, mock.hits)
+	for i := range hits {
+		hits[i] = search.HitDto{Name: fmt.Sprintf("node-%d", i), Score: mock.hits - i}
+	}
+	return hits, nil
+}
+
+func newApp(mock *searchServiceMock) *fiber.App {
+	service = mock
+	app := fiber.New()
+	app.Get("/search", func(c *fiber.Ctx) error {
+		c.Locals("namespace", "default")
+		return c.Next()
+	}, Search)
+	return app
+}
+
+func TestSearch(t *testing.T) {
+	t.Run("query is required", func(t *testing.T) {
+		for _, query := range []string{"", "%20%20", strings.Repeat("a", maxQueryLength+1)} {
+			resp, err := newApp(&searchServiceMock{}).Test(httptest.NewRequest(http.MethodGet, "/search?q="+query, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		}
+	})
+
+	t.Run("ranked hits", func(t *testing.T) {
+		mock := &searchServiceMock{hits: 3}
+		resp, err := newApp(mock).Test(httptest.NewRequest(http.MethodGet, "/search?q="+url.QueryEscape(" node "), nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "3", resp.Header.Get("X-Total-Count"))
+		assert.Equal(t, "default", mock.namespace)
+		assert.Equal(t, "node", mock.query)
+
+		body, _ := io.ReadAll(resp.Body)
+		response := struct {
+			Data []search.HitDto `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(body, &response))
+		assert.Len(t, response.Data, 3)
+		assert.Equal(t, "node-0", response.Data[0].Name)
+	})
+
+	t.Run("paginated hits", func(t *testing.T) {
+		resp, err := newApp(&searchServiceMock{hits: 15}).Test(httptest.NewRequest(http.MethodGet, "/search?q=node&page=1", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, "15", resp.Header.Get("X-Total-Count"))
+
+		body, _ := io.ReadAll(resp.Body)
+		response := struct {
+			Data []search.HitDto `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(body, &response))
+		assert.Len(t, response.Data, 5)
+		assert.Equal(t, "node-10", response.Data[0].Name)
+	})
+}
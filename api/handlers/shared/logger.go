@@ -1,19 +1,41 @@
 package shared
 
 import (
-	"context"
+	"bufio"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/gofiber/websocket/v2"
+	restError "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
-	corev1 "k8s.io/api/core/v1"
+	"github.com/kotalco/community-api/pkg/server"
+	"github.com/kotalco/community-api/pkg/shared"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// Logger returns a websocket that emits logs from pod
+const (
+	defaultTailLines = int64(100)
+	maxTailLines     = int64(10000)
+	// maxLogLineLength is the length of the longest log line, the stream ends with an error on longer lines
+	maxLogLineLength = 1024 * 1024
+)
+
+// Logger returns a websocket that emits the logs of the pod as json lines
+// query strings: tailLines (100 by default), sinceSeconds or sinceTime, timestamps (true by default), container,
+// previous for the logs of the previous container, filter a regular expression and level the minimum level of the lines
 func Logger(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
+
+	opts, restErr := logOptions(c)
+	if restErr != nil {
+		c.WriteJSON(shared.NewResponse(restErr))
+		return
+	}
 
 	if os.Getenv("MOCK") == "true" {
 		var i int
@@ -22,47 +44,116 @@ func Logger(c *websocket.Conn) {
 			if i == 10 {
 				return
 			}
-			msg := fmt.Sprintf("%s \n", time.Now().Local())
-			c.WriteMessage(websocket.TextMessage, []byte(msg))
-			time.Sleep(time.Second)
+			line := k8s.LogLineDto{
+				Timestamp: time.Now().UTC().Format(shared.JavascriptISOString),
+				Stream:    k8s.LogStreamCurrent,
+				Level:     "info",
+				Message:   fmt.Sprintf("INFO mock log line %d", i),
+			}
+			c.WriteJSON(line)
+			if !server.Sleep(ctx, time.Second) {
+				return
+			}
 		}
 	}
 
-	lines := int64(100)
-
-	opts := corev1.PodLogOptions{
-		Follow:    true,
-		TailLines: &lines,
-	}
-
 	ns := c.Locals("namespace").(string)
 	name := fmt.Sprintf("%s-0", c.Params("name"))
-	logs := k8s.Clientset().CoreV1().Pods(ns).GetLogs(name, &opts)
+	logs := k8s.Clientset().CoreV1().Pods(ns).GetLogs(name, opts.PodLogOptions())
 
-	stream, err := logs.Stream(context.TODO())
+	stream, err := logs.Stream(ctx)
 	if stream != nil {
 		defer stream.Close()
 	}
 	if err != nil {
-		c.WriteMessage(websocket.TextMessage, []byte(err.Error()))
+		switch {
+		case apierrors.IsNotFound(err):
+			c.WriteJSON(shared.NewResponse(restError.NewNotFoundError(err.Error())))
+		case apierrors.IsBadRequest(err):
+			c.WriteJSON(shared.NewResponse(restError.NewBadRequestError(err.Error())))
+		default:
+			c.WriteJSON(shared.NewResponse(restError.NewInternalServerError(err.Error())))
+		}
 		return
 	}
 
-	for {
-		buf := make([]byte, 1024)
+	filter := k8s.NewLogFilter(opts)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineLength)
+	for scanner.Scan() {
+		line, ok := filter.Line(scanner.Text())
+		if !ok {
+			continue
+		}
+		if err := c.WriteJSON(line); err != nil {
+			return
+		}
+	}
 
-		numBytes, err := stream.Read(buf)
+	if err := scanner.Err(); err == bufio.ErrTooLong {
+		c.WriteJSON(shared.NewResponse(restError.NewInternalServerError(fmt.Sprintf("log line is longer than %d bytes", maxLogLineLength))))
+	}
+}
+
+// logOptions returns the log options of the query strings, invalid query strings are returned as validation errors
+func logOptions(c *websocket.Conn) (k8s.LogOptions, restError.IRestErr) {
+	opts := k8s.LogOptions{
+		Container:  c.Query("container"),
+		TailLines:  defaultTailLines,
+		Timestamps: true,
+		Level:      c.Query("level"),
+	}
+	validations := map[string]string{}
+
+	if value := c.Query("tailLines"); value != "" {
+		tailLines, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || tailLines < 0 || tailLines > maxTailLines {
+			validations["tailLines"] = fmt.Sprintf("tailLines must be between 0 and %d", maxTailLines)
+		}
+		opts.TailLines = tailLines
+	}
+	if value := c.Query("sinceSeconds"); value != "" {
+		sinceSeconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || sinceSeconds < 1 {
+			validations["sinceSeconds"] = "sinceSeconds must be a positive number of seconds"
+		}
+		opts.SinceSeconds = &sinceSeconds
+	}
+	if value := c.Query("sinceTime"); value != "" {
+		sinceTime, err := time.Parse(time.RFC3339, value)
 		if err != nil {
-			return
+			validations["sinceTime"] = "sinceTime must be an RFC3339 time"
+		}
+		if opts.SinceSeconds != nil {
+			validations["sinceTime"] = "only one of sinceSeconds or sinceTime can be set"
 		}
+		opts.SinceTime = &metav1.Time{Time: sinceTime}
+	}
 
-		if numBytes == 0 {
-			time.Sleep(time.Second)
+	for key, value := range map[string]*bool{"timestamps": &opts.Timestamps, "previous": &opts.Previous} {
+		if c.Query(key) == "" {
 			continue
 		}
+		parsed, err := strconv.ParseBool(c.Query(key))
+		if err != nil {
+			validations[key] = fmt.Sprintf("%s must be true or false", key)
+		}
+		*value = parsed
+	}
 
-		if err := c.WriteMessage(websocket.TextMessage, buf[:numBytes]); err != nil {
-			return
+	if value := c.Query("filter"); value != "" {
+		filter, err := regexp.Compile(value)
+		if err != nil {
+			validations["filter"] = "filter must be a valid regular expression"
 		}
+		opts.Filter = filter
+	}
+	if opts.Level != "" && !k8s.IsLogLevel(opts.Level) {
+		validations["level"] = "level must be one of trace, debug, info, warn, error or fatal"
+	}
+
+	if len(validations) > 0 {
+		return k8s.LogOptions{}, restError.NewValidationError(validations)
 	}
+	return opts, nil
 }
@@ -1,12 +1,12 @@
 package shared
 
 import (
-	"context"
 	"fmt"
 	"time"
 
 	restError "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/server"
 	"github.com/kotalco/community-api/pkg/shared"
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -19,7 +19,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-var metricsClientset = k8s.MetricsClientset()
+// statsLogger logs the stats, status and metrics pollers errors, its logs are sampled as they're repeated every poll
+var statsLogger = logger.Component(logger.Stats)
+
+const (
+	metricsMinBackoff = time.Second
+	metricsMaxBackoff = 30 * time.Second
+)
 
 type metricsResponseDto struct {
 	Cpu    int64 `json:"cpu"`
@@ -27,9 +33,19 @@ type metricsResponseDto struct {
 }
 
 // Metrics returns a websocket that emits cpu and memory usage
+// metrics are optional, if the metrics api isn't installed the socket emits an error and closes
+// failures to reach the pod or the metrics api are retried with exponential backoff until the stream is closed
 func Metrics(c *websocket.Conn) {
 	defer c.Close()
 
+	metricsClientset := k8s.MetricsClientset()
+	if metricsClientset == nil || !k8s.Discovery().IsServed(k8s.PodMetricsResource) {
+		c.WriteJSON(shared.NewResponse(restError.NewNotImplementedError("metrics api isn't available, metrics-server isn't installed in the cluster")))
+		return
+	}
+
+	ctx := server.StreamContext(c)
+
 	name := c.Params("name")
 	ns := c.Locals("namespace").(string)
 	pod := &corev1.Pod{}
@@ -45,33 +61,39 @@ func Metrics(c *websocket.Conn) {
 		Name:      name,
 	}
 
-podCheck:
-	if err := k8sClient.Get(context.Background(), key, pod); err != nil {
-		go logger.Info("METRICS_POD_NOTFOUND", err.Error())
-		// is the pod error due to sts has been deleted ?
-		stsErr := k8sClient.Get(context.Background(), stsKey, sts)
-		if apierrors.IsNotFound(stsErr) {
-			go logger.Info("METRICS_STS_NOTFOUND", stsErr.Error())
-			c.WriteJSON(shared.NewResponse(restError.NewNotFoundError(stsErr.Error())))
-			return
-		}
-		time.Sleep(3 * time.Second)
-		goto podCheck
-	}
-
 	opts := metav1.GetOptions{}
 	podMetrics := metricsClientset.MetricsV1beta1().PodMetricses(key.Namespace)
+	backoff := shared.NewBackoff(metricsMinBackoff, metricsMaxBackoff)
 
 	for {
-		response := new(metricsResponseDto)
+		if err := k8sClient.Get(ctx, key, pod); err != nil {
+			statsLogger.InfoContext(ctx, "METRICS_POD_NOTFOUND", err.Error())
+			// is the pod error due to sts has been deleted ?
+			stsErr := k8sClient.Get(ctx, stsKey, sts)
+			if apierrors.IsNotFound(stsErr) {
+				statsLogger.InfoContext(ctx, "METRICS_STS_NOTFOUND", stsErr.Error())
+				c.WriteJSON(shared.NewResponse(restError.NewNotFoundError(stsErr.Error())))
+				return
+			}
+			if !server.Sleep(ctx, backoff.Next()) {
+				return
+			}
+			continue
+		}
 
-		metrics, err := podMetrics.Get(context.Background(), key.Name, opts)
-		if err != nil {
-			go logger.Info("METRICS_API_ERR", err.Error())
-			time.Sleep(3 * time.Second)
-			goto podCheck
+		metrics, err := podMetrics.Get(ctx, key.Name, opts)
+		if err != nil || len(metrics.Containers) == 0 {
+			if err != nil {
+				statsLogger.InfoContext(ctx, "METRICS_API_ERR", err.Error())
+			}
+			if !server.Sleep(ctx, backoff.Next()) {
+				return
+			}
+			continue
 		}
+		backoff.Reset()
 
+		response := new(metricsResponseDto)
 		response.Cpu = metrics.Containers[0].Usage.Cpu().ScaledValue(resource.Milli)
 		response.Memory = metrics.Containers[0].Usage.Memory().ScaledValue(resource.Mega)
 
@@ -79,6 +101,8 @@ podCheck:
 			return
 		}
 
-		time.Sleep(time.Second)
+		if !server.Sleep(ctx, time.Second) {
+			return
+		}
 	}
 }
@@ -1,7 +1,6 @@
 package shared
 
 import (
-	"context"
 	"fmt"
 	"math/rand"
 	"os"
@@ -9,21 +8,19 @@ import (
 
 	"github.com/gofiber/websocket/v2"
 	"github.com/kotalco/community-api/pkg/k8s"
-	"github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/server"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-var (
-	k8sClient       = k8s.NewClientService()
-	k8sClientset, _ = k8s.NewClientset()
-)
+var k8sClient = k8s.NewClientService()
 
 // Status returns a websocket that emits logs from pod
 // Possible values are: NotFound, Pending, PodInitializing, ContainerCreating, Running, Error, Terminating
 func Status(c *websocket.Conn) {
 	defer c.Close()
+	ctx := server.StreamContext(c)
 
 	if os.Getenv("MOCK") == "true" {
 		statuses := []string{
@@ -39,7 +36,9 @@ func Status(c *websocket.Conn) {
 		for {
 			i := rand.Intn(len(statuses))
 			c.WriteMessage(websocket.TextMessage, []byte(statuses[i]))
-			time.Sleep(time.Second)
+			if !server.Sleep(ctx, time.Second) {
+				return
+			}
 		}
 	}
 
@@ -47,13 +46,14 @@ func Status(c *websocket.Conn) {
 	name := c.Params("name")
 	selector := fmt.Sprintf("app.kubernetes.io/managed-by=kotal-operator,app.kubernetes.io/instance=%s", name)
 
-	watch, err := k8sClientset.CoreV1().Pods(ns).Watch(context.Background(), metav1.ListOptions{
+	watch, err := k8s.Clientset().CoreV1().Pods(ns).Watch(ctx, metav1.ListOptions{
 		LabelSelector: selector,
 	})
 	if err != nil {
-		go logger.Info("STATUS_STREAM", err.Error())
+		statsLogger.InfoContext(ctx, "STATUS_STREAM", err.Error())
 		return
 	}
+	defer watch.Stop()
 
 	for event := range watch.ResultChan() {
 
@@ -69,8 +69,10 @@ func Status(c *websocket.Conn) {
 
 			// if pod is being terminated, check owner sts is found or not
 			go func() {
-				time.Sleep(3 * time.Second)
-				_, err := k8sClientset.AppsV1().StatefulSets(ns).Get(context.Background(), name, metav1.GetOptions{})
+				if !server.Sleep(ctx, 3*time.Second) {
+					return
+				}
+				_, err := k8s.Clientset().AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
 				if err != nil && apierrors.IsNotFound(err) {
 					watch.Stop()
 				}
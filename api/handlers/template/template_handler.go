@@ -0,0 +1,60 @@
+// Package template handler is the representation layer for the template domain
+// creates multi-resource stacks from built-in templates
+package template
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/template"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/shared"
+)
+
+const (
+	templateKeyword = "template"
+)
+
+var service = template.NewTemplateService()
+
+// List returns the built-in templates and their parameters
+func List(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(service.List()))
+}
+
+// Get returns a built-in template by name
+func Get(c *fiber.Ctx) error {
+	dto, err := service.Get(c.Params(templateKeyword))
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusOK).JSON(shared.NewResponse(dto))
+}
+
+// Create creates the resources of a template as a stack
+// 1-validate the stack name
+// 2-call service to create the stack resources, rolled back on failure
+// 3-format the created resources using NewResponse
+func Create(c *fiber.Ctx) error {
+	dto := new(template.StackDto)
+	if err := c.BodyParser(dto); err != nil {
+		badReq := restErrors.NewBadRequestError("invalid request body")
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+
+	dto.Namespace = c.Locals("namespace").(string)
+
+	meta := k8s.MetaDataDto{Name: dto.Name}
+	if err := meta.Validate(); err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	result, err := service.Create(c.UserContext(), c.Params(templateKeyword), *dto)
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(shared.NewResponse(result))
+}
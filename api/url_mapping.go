@@ -2,23 +2,47 @@ package api
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/websocket/v2"
+	"github.com/kotalco/community-api/api/handlers/admin"
 	"github.com/kotalco/community-api/api/handlers/aptos"
 	"github.com/kotalco/community-api/api/handlers/bitcoin"
+	"github.com/kotalco/community-api/api/handlers/bundle"
+	"github.com/kotalco/community-api/api/handlers/capabilities"
 	"github.com/kotalco/community-api/api/handlers/chainlink"
+	"github.com/kotalco/community-api/api/handlers/clone"
+	"github.com/kotalco/community-api/api/handlers/core/name"
 	"github.com/kotalco/community-api/api/handlers/core/secret"
 	"github.com/kotalco/community-api/api/handlers/core/storage_class"
+	"github.com/kotalco/community-api/api/handlers/diagnostic"
 	"github.com/kotalco/community-api/api/handlers/ethereum"
 	"github.com/kotalco/community-api/api/handlers/ethereum2/beacon_node"
 	"github.com/kotalco/community-api/api/handlers/ethereum2/validator"
+	"github.com/kotalco/community-api/api/handlers/event"
 	"github.com/kotalco/community-api/api/handlers/filecoin"
+	"github.com/kotalco/community-api/api/handlers/health"
 	"github.com/kotalco/community-api/api/handlers/ipfs/ipfs_cluster_peer"
 	"github.com/kotalco/community-api/api/handlers/ipfs/ipfs_peer"
+	"github.com/kotalco/community-api/api/handlers/manifest"
 	"github.com/kotalco/community-api/api/handlers/near"
+	"github.com/kotalco/community-api/api/handlers/overview"
 	"github.com/kotalco/community-api/api/handlers/polkadot"
+	"github.com/kotalco/community-api/api/handlers/project"
+	"github.com/kotalco/community-api/api/handlers/search"
 	"github.com/kotalco/community-api/api/handlers/shared"
 	"github.com/kotalco/community-api/api/handlers/stacks"
+	"github.com/kotalco/community-api/api/handlers/template"
+	"github.com/kotalco/community-api/pkg/configs"
 	"github.com/kotalco/community-api/pkg/middleware"
+	"github.com/kotalco/community-api/pkg/server"
+	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
+	bitcoinv1alpha1 "github.com/kotalco/kotal/apis/bitcoin/v1alpha1"
+	chainlinkv1alpha1 "github.com/kotalco/kotal/apis/chainlink/v1alpha1"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	filecoinv1alpha1 "github.com/kotalco/kotal/apis/filecoin/v1alpha1"
+	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
+	nearv1alpha1 "github.com/kotalco/kotal/apis/near/v1alpha1"
+	polkadotv1alpha1 "github.com/kotalco/kotal/apis/polkadot/v1alpha1"
+	stacksv1alpha1 "github.com/kotalco/kotal/apis/stacks/v1alpha1"
 )
 
 // MapUrl abstracted function to map and register all the url for the application
@@ -26,38 +50,68 @@ import (
 // helps to keep all the endpoints' definition in one place
 // the only place to interact with handlers and middlewares
 func MapUrl(app *fiber.App, handlers ...fiber.Handler) {
+	app.Get("readyz", health.Readiness)
+
 	// routing groups
 	api := app.Group("api")
 	v1 := api.Group("v1")
+	v1.Use(middleware.SetIdentity)
+	v1.Use(middleware.RateLimit(configs.Settings.RateLimit))
 	for i := 0; i < len(handlers); i++ {
 		v1.Use(handlers[i])
 	}
 	v1.Use(middleware.SetNamespace)
+
+	features := configs.Settings.Features
+
+	v1.Get("capabilities", capabilities.Get)
+	v1.Get("search", search.Search)
+	v1.Get("overview", overview.Get)
+	v1.Get("export", manifest.Export)
+	v1.Post("import", middleware.Idempotency, manifest.Import)
+
+	adminGroup := v1.Group("admin", middleware.IsAdmin)
+	adminGroup.Get("/config", admin.Config)
+	adminGroup.Get("/log/levels", admin.LogLevels)
+	adminGroup.Put("/log/levels/:component", admin.SetLogLevel)
+
 	// chainlink group
 	chainlinkGroup := v1.Group("chainlink")
-	chainlinkNodes := chainlinkGroup.Group("nodes")
+	chainlinkNodes := chainlinkGroup.Group("nodes", middleware.IsServed(chainlinkv1alpha1.GroupVersion.WithKind("Node")))
 
-	chainlinkNodes.Post("/", middleware.IsDuplicated, chainlink.Create)
+	chainlinkNodes.Post("/", middleware.Idempotency, middleware.IsNameAvailable, chainlink.Create)
 	chainlinkNodes.Head("/", chainlink.Count)
 	chainlinkNodes.Get("/", chainlink.List)
 	chainlinkNodes.Get("/:name", chainlink.ValidateNodeExist, chainlink.Get)
-	chainlinkNodes.Get("/:name/logs", websocket.New(shared.Logger))
-	chainlinkNodes.Get("/:name/status", websocket.New(shared.Status))
-	chainlinkNodes.Get("/:name/metrics", websocket.New(shared.Metrics))
+	chainlinkNodes.Get("/:name/manifest", manifest.Get(chainlinkv1alpha1.GroupVersion.WithKind("Node")))
+	chainlinkNodes.Get("/:name/events", middleware.Feature("events", features.Events), event.List(chainlinkv1alpha1.GroupVersion.WithKind("Node")))
+	chainlinkNodes.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(chainlinkv1alpha1.GroupVersion.WithKind("Node"))))
+	chainlinkNodes.Get("/:name/support-bundle", bundle.Get(chainlinkv1alpha1.GroupVersion.WithKind("Node")))
+	chainlinkNodes.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(chainlinkv1alpha1.GroupVersion.WithKind("Node")))
+	chainlinkNodes.Get("/:name/diagnostics", chainlink.ValidateNodeExist, diagnostic.Get)
+	chainlinkNodes.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	chainlinkNodes.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	chainlinkNodes.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	chainlinkNodes.Put("/:name", chainlink.ValidateNodeExist, chainlink.Update)
 	chainlinkNodes.Delete("/:name", chainlink.ValidateNodeExist, chainlink.Delete)
 
 	//ethereum group
 	ethereumGroup := v1.Group("ethereum")
-	ethereumNodes := ethereumGroup.Group("nodes")
-	ethereumNodes.Post("/", middleware.IsDuplicated, ethereum.Create)
+	ethereumNodes := ethereumGroup.Group("nodes", middleware.IsServed(ethereumv1alpha1.GroupVersion.WithKind("Node")))
+	ethereumNodes.Post("/", middleware.Idempotency, middleware.IsNameAvailable, ethereum.Create)
 	ethereumNodes.Head("/", ethereum.Count)
 	ethereumNodes.Get("/", ethereum.List)
 	ethereumNodes.Get("/:name", ethereum.ValidateNodeExist, ethereum.Get)
-	ethereumNodes.Get("/:name/logs", websocket.New(shared.Logger))
-	ethereumNodes.Get("/:name/status", websocket.New(shared.Status))
-	ethereumNodes.Get("/:name/stats", websocket.New(ethereum.Stats))
-	ethereumNodes.Get("/:name/metrics", websocket.New(shared.Metrics))
+	ethereumNodes.Get("/:name/manifest", manifest.Get(ethereumv1alpha1.GroupVersion.WithKind("Node")))
+	ethereumNodes.Get("/:name/events", middleware.Feature("events", features.Events), event.List(ethereumv1alpha1.GroupVersion.WithKind("Node")))
+	ethereumNodes.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(ethereumv1alpha1.GroupVersion.WithKind("Node"))))
+	ethereumNodes.Get("/:name/support-bundle", bundle.Get(ethereumv1alpha1.GroupVersion.WithKind("Node")))
+	ethereumNodes.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(ethereumv1alpha1.GroupVersion.WithKind("Node")))
+	ethereumNodes.Get("/:name/diagnostics", ethereum.ValidateNodeExist, diagnostic.Get)
+	ethereumNodes.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	ethereumNodes.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	ethereumNodes.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(ethereum.Stats))
+	ethereumNodes.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	ethereumNodes.Put("/:name", ethereum.ValidateNodeExist, ethereum.Update)
 	ethereumNodes.Delete("/:name", ethereum.ValidateNodeExist, ethereum.Delete)
 
@@ -65,12 +119,15 @@ func MapUrl(app *fiber.App, handlers ...fiber.Handler) {
 	coreGroup := v1.Group("core")
 	//secret group
 	secrets := coreGroup.Group("secrets")
-	secrets.Post("/", secret.Create)
+	secrets.Post("/", middleware.Idempotency, middleware.IsNameAvailable, secret.Create)
 	secrets.Head("/", secret.Count)
 	secrets.Get("/", secret.List)
 	secrets.Get("/:name", secret.ValidateSecretExist, secret.Get)
 	secrets.Put("/:name", secret.ValidateSecretExist, secret.Update)
 	secrets.Delete("/:name", secret.ValidateSecretExist, secret.Delete)
+	//names group
+	names := coreGroup.Group("names")
+	names.Get("/:name/available", name.Available)
 	//storage class group
 	storageClasses := coreGroup.Group("storageclasses")
 	storageClasses.Get("/", storage_class.List)
@@ -78,134 +135,209 @@ func MapUrl(app *fiber.App, handlers ...fiber.Handler) {
 	storageClasses.Put("/:name", storage_class.ValidateStorageClassExist, storage_class.Update)
 	storageClasses.Delete("/:name", storage_class.ValidateStorageClassExist, storage_class.Delete)
 
+	//projects group
+	projects := v1.Group("projects")
+	projects.Post("/", middleware.Idempotency, project.Create)
+	projects.Get("/", project.List)
+	projects.Get("/:name", project.ValidateProjectExist, project.Get)
+	projects.Delete("/:name", project.ValidateProjectExist, project.Delete)
+	projects.Post("/:name/members", project.ValidateProjectExist, project.Attach)
+	projects.Delete("/:name/members/:protocol/:resource/:member", project.ValidateProjectExist, project.Detach)
+
+	//templates group
+	templates := v1.Group("templates")
+	templates.Get("/", template.List)
+	templates.Get("/:template", template.Get)
+	templates.Post("/:template", middleware.Idempotency, template.Create)
+
 	//ethereum2 group
 	ethereum2 := v1.Group("ethereum2")
 	//beaconnodes group
-	beaconnodesGroup := ethereum2.Group("beaconnodes")
-	beaconnodesGroup.Post("/", middleware.IsDuplicated, beacon_node.Create)
+	beaconnodesGroup := ethereum2.Group("beaconnodes", middleware.IsServed(ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode")))
+	beaconnodesGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, beacon_node.Create)
 	beaconnodesGroup.Head("/", beacon_node.Count)
 	beaconnodesGroup.Get("/", beacon_node.List)
 	beaconnodesGroup.Get("/:name", beacon_node.ValidateBeaconNodeExist, beacon_node.Get)
-	beaconnodesGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	beaconnodesGroup.Get("/:name/status", websocket.New(shared.Status))
-	beaconnodesGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
-	beaconnodesGroup.Get("/:name/stats", websocket.New(beacon_node.Stats))
+	beaconnodesGroup.Get("/:name/manifest", manifest.Get(ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode")))
+	beaconnodesGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode")))
+	beaconnodesGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode"))))
+	beaconnodesGroup.Get("/:name/support-bundle", bundle.Get(ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode")))
+	beaconnodesGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode")))
+	beaconnodesGroup.Get("/:name/diagnostics", beacon_node.ValidateBeaconNodeExist, diagnostic.Get)
+	beaconnodesGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	beaconnodesGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	beaconnodesGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
+	beaconnodesGroup.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(beacon_node.Stats))
 	beaconnodesGroup.Put("/:name", beacon_node.ValidateBeaconNodeExist, beacon_node.Update)
 	beaconnodesGroup.Delete("/:name", beacon_node.ValidateBeaconNodeExist, beacon_node.Delete)
 	//validators group
-	validatorsGroup := ethereum2.Group("validators")
-	validatorsGroup.Post("/", middleware.IsDuplicated, validator.Create)
+	validatorsGroup := ethereum2.Group("validators", middleware.IsServed(ethereum2v1alpha1.GroupVersion.WithKind("Validator")))
+	validatorsGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, validator.Create)
 	validatorsGroup.Head("/", validator.Count)
 	validatorsGroup.Get("/", validator.List)
 	validatorsGroup.Get("/:name", validator.ValidateValidatorExist, validator.Get)
-	validatorsGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	validatorsGroup.Get("/:name/status", websocket.New(shared.Status))
-	validatorsGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
+	validatorsGroup.Get("/:name/manifest", manifest.Get(ethereum2v1alpha1.GroupVersion.WithKind("Validator")))
+	validatorsGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(ethereum2v1alpha1.GroupVersion.WithKind("Validator")))
+	validatorsGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(ethereum2v1alpha1.GroupVersion.WithKind("Validator"))))
+	validatorsGroup.Get("/:name/support-bundle", bundle.Get(ethereum2v1alpha1.GroupVersion.WithKind("Validator")))
+	validatorsGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(ethereum2v1alpha1.GroupVersion.WithKind("Validator")))
+	validatorsGroup.Get("/:name/diagnostics", validator.ValidateValidatorExist, diagnostic.Get)
+	validatorsGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	validatorsGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	validatorsGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	validatorsGroup.Put("/:name", validator.ValidateValidatorExist, validator.Update)
 	validatorsGroup.Delete("/:name", validator.ValidateValidatorExist, validator.Delete)
 
 	//filecoin group
 	filecoinGroup := v1.Group("filecoin")
-	filecoinNodes := filecoinGroup.Group("nodes")
-	filecoinNodes.Post("/", middleware.IsDuplicated, filecoin.Create)
+	filecoinNodes := filecoinGroup.Group("nodes", middleware.IsServed(filecoinv1alpha1.GroupVersion.WithKind("Node")))
+	filecoinNodes.Post("/", middleware.Idempotency, middleware.IsNameAvailable, filecoin.Create)
 	filecoinNodes.Head("/", filecoin.Count)
 	filecoinNodes.Get("/", filecoin.List)
 	filecoinNodes.Get("/:name", filecoin.ValidateNodeExist, filecoin.Get)
-	filecoinNodes.Get("/:name/logs", websocket.New(shared.Logger))
-	filecoinNodes.Get("/:name/status", websocket.New(shared.Status))
-	filecoinNodes.Get("/:name/metrics", websocket.New(shared.Metrics))
+	filecoinNodes.Get("/:name/manifest", manifest.Get(filecoinv1alpha1.GroupVersion.WithKind("Node")))
+	filecoinNodes.Get("/:name/events", middleware.Feature("events", features.Events), event.List(filecoinv1alpha1.GroupVersion.WithKind("Node")))
+	filecoinNodes.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(filecoinv1alpha1.GroupVersion.WithKind("Node"))))
+	filecoinNodes.Get("/:name/support-bundle", bundle.Get(filecoinv1alpha1.GroupVersion.WithKind("Node")))
+	filecoinNodes.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(filecoinv1alpha1.GroupVersion.WithKind("Node")))
+	filecoinNodes.Get("/:name/diagnostics", filecoin.ValidateNodeExist, diagnostic.Get)
+	filecoinNodes.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	filecoinNodes.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	filecoinNodes.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	filecoinNodes.Put("/:name", filecoin.ValidateNodeExist, filecoin.Update)
 	filecoinNodes.Delete("/:name", filecoin.ValidateNodeExist, filecoin.Delete)
 
 	//ipfs group
 	ipfsGroup := v1.Group("ipfs")
 	//ipfs peer group
-	ipfsPeersGroup := ipfsGroup.Group("peers")
-	ipfsPeersGroup.Post("/", middleware.IsDuplicated, ipfs_peer.Create)
+	ipfsPeersGroup := ipfsGroup.Group("peers", middleware.IsServed(ipfsv1alpha1.GroupVersion.WithKind("Peer")))
+	ipfsPeersGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, ipfs_peer.Create)
 	ipfsPeersGroup.Head("/", ipfs_peer.Count)
 	ipfsPeersGroup.Get("/", ipfs_peer.List)
 	ipfsPeersGroup.Get("/:name", ipfs_peer.ValidatePeerExist, ipfs_peer.Get)
-	ipfsPeersGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	ipfsPeersGroup.Get("/:name/status", websocket.New(shared.Status))
-	ipfsPeersGroup.Get("/:name/stats", websocket.New(ipfs_peer.Stats))
-	ipfsPeersGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
+	ipfsPeersGroup.Get("/:name/manifest", manifest.Get(ipfsv1alpha1.GroupVersion.WithKind("Peer")))
+	ipfsPeersGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(ipfsv1alpha1.GroupVersion.WithKind("Peer")))
+	ipfsPeersGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(ipfsv1alpha1.GroupVersion.WithKind("Peer"))))
+	ipfsPeersGroup.Get("/:name/support-bundle", bundle.Get(ipfsv1alpha1.GroupVersion.WithKind("Peer")))
+	ipfsPeersGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(ipfsv1alpha1.GroupVersion.WithKind("Peer")))
+	ipfsPeersGroup.Get("/:name/diagnostics", ipfs_peer.ValidatePeerExist, diagnostic.Get)
+	ipfsPeersGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	ipfsPeersGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	ipfsPeersGroup.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(ipfs_peer.Stats))
+	ipfsPeersGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	ipfsPeersGroup.Put("/:name", ipfs_peer.ValidatePeerExist, ipfs_peer.Update)
 	ipfsPeersGroup.Delete("/:name", ipfs_peer.ValidatePeerExist, ipfs_peer.Delete)
 	//ipfs peer group
-	clusterpeersGroup := ipfsGroup.Group("clusterpeers")
-	clusterpeersGroup.Post("/", middleware.IsDuplicated, ipfs_cluster_peer.Create)
+	clusterpeersGroup := ipfsGroup.Group("clusterpeers", middleware.IsServed(ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer")))
+	clusterpeersGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, ipfs_cluster_peer.Create)
 	clusterpeersGroup.Head("/", ipfs_cluster_peer.Count)
 	clusterpeersGroup.Get("/", ipfs_cluster_peer.List)
 	clusterpeersGroup.Get("/:name", ipfs_cluster_peer.ValidateClusterPeerExist, ipfs_cluster_peer.Get)
-	clusterpeersGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	clusterpeersGroup.Get("/:name/status", websocket.New(shared.Status))
-	clusterpeersGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
+	clusterpeersGroup.Get("/:name/manifest", manifest.Get(ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer")))
+	clusterpeersGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer")))
+	clusterpeersGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer"))))
+	clusterpeersGroup.Get("/:name/support-bundle", bundle.Get(ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer")))
+	clusterpeersGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer")))
+	clusterpeersGroup.Get("/:name/diagnostics", ipfs_cluster_peer.ValidateClusterPeerExist, diagnostic.Get)
+	clusterpeersGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	clusterpeersGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	clusterpeersGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	clusterpeersGroup.Put("/:name", ipfs_cluster_peer.ValidateClusterPeerExist, ipfs_cluster_peer.Update)
 	clusterpeersGroup.Delete("/:name", ipfs_cluster_peer.ValidateClusterPeerExist, ipfs_cluster_peer.Delete)
 
 	//near group
 	nearGroup := v1.Group("near")
-	nearNodesGroup := nearGroup.Group("nodes")
-	nearNodesGroup.Post("/", middleware.IsDuplicated, near.Create)
+	nearNodesGroup := nearGroup.Group("nodes", middleware.IsServed(nearv1alpha1.GroupVersion.WithKind("Node")))
+	nearNodesGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, near.Create)
 	nearNodesGroup.Head("/", near.Count)
 	nearNodesGroup.Get("/", near.List)
 	nearNodesGroup.Get("/:name", near.ValidateNodeExist, near.Get)
-	nearNodesGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	nearNodesGroup.Get("/:name/status", websocket.New(shared.Status))
-	nearNodesGroup.Get("/:name/stats", websocket.New(near.Stats))
-	nearNodesGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
+	nearNodesGroup.Get("/:name/manifest", manifest.Get(nearv1alpha1.GroupVersion.WithKind("Node")))
+	nearNodesGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(nearv1alpha1.GroupVersion.WithKind("Node")))
+	nearNodesGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(nearv1alpha1.GroupVersion.WithKind("Node"))))
+	nearNodesGroup.Get("/:name/support-bundle", bundle.Get(nearv1alpha1.GroupVersion.WithKind("Node")))
+	nearNodesGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(nearv1alpha1.GroupVersion.WithKind("Node")))
+	nearNodesGroup.Get("/:name/diagnostics", near.ValidateNodeExist, diagnostic.Get)
+	nearNodesGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	nearNodesGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	nearNodesGroup.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(near.Stats))
+	nearNodesGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	nearNodesGroup.Put("/:name", near.ValidateNodeExist, near.Update)
 	nearNodesGroup.Delete("/:name", near.ValidateNodeExist, near.Delete)
 
 	polkadotGroup := v1.Group("polkadot")
-	polkadotNodesGroup := polkadotGroup.Group("nodes")
-	polkadotNodesGroup.Post("/", middleware.IsDuplicated, polkadot.Create)
+	polkadotNodesGroup := polkadotGroup.Group("nodes", middleware.IsServed(polkadotv1alpha1.GroupVersion.WithKind("Node")))
+	polkadotNodesGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, polkadot.Create)
 	polkadotNodesGroup.Head("/", polkadot.Count)
 	polkadotNodesGroup.Get("/", polkadot.List)
 	polkadotNodesGroup.Get("/:name", polkadot.ValidateNodeExist, polkadot.Get)
-	polkadotNodesGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	polkadotNodesGroup.Get("/:name/status", websocket.New(shared.Status))
-	polkadotNodesGroup.Get("/:name/stats", websocket.New(polkadot.Stats))
-	polkadotNodesGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
+	polkadotNodesGroup.Get("/:name/manifest", manifest.Get(polkadotv1alpha1.GroupVersion.WithKind("Node")))
+	polkadotNodesGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(polkadotv1alpha1.GroupVersion.WithKind("Node")))
+	polkadotNodesGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(polkadotv1alpha1.GroupVersion.WithKind("Node"))))
+	polkadotNodesGroup.Get("/:name/support-bundle", bundle.Get(polkadotv1alpha1.GroupVersion.WithKind("Node")))
+	polkadotNodesGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(polkadotv1alpha1.GroupVersion.WithKind("Node")))
+	polkadotNodesGroup.Get("/:name/diagnostics", polkadot.ValidateNodeExist, diagnostic.Get)
+	polkadotNodesGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	polkadotNodesGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	polkadotNodesGroup.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(polkadot.Stats))
+	polkadotNodesGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 	polkadotNodesGroup.Put("/:name", polkadot.ValidateNodeExist, polkadot.Update)
 	polkadotNodesGroup.Delete("/:name", polkadot.ValidateNodeExist, polkadot.Delete)
 
 	bitcoinGroup := v1.Group("bitcoin")
-	bitcoinNodesGroup := bitcoinGroup.Group("nodes")
-	bitcoinNodesGroup.Post("/", middleware.IsDuplicated, bitcoin.Create)
+	bitcoinNodesGroup := bitcoinGroup.Group("nodes", middleware.IsServed(bitcoinv1alpha1.GroupVersion.WithKind("Node")))
+	bitcoinNodesGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, bitcoin.Create)
 	bitcoinNodesGroup.Get("/:name", bitcoin.ValidateNodeExist, bitcoin.Get)
+	bitcoinNodesGroup.Get("/:name/manifest", manifest.Get(bitcoinv1alpha1.GroupVersion.WithKind("Node")))
+	bitcoinNodesGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(bitcoinv1alpha1.GroupVersion.WithKind("Node")))
+	bitcoinNodesGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(bitcoinv1alpha1.GroupVersion.WithKind("Node"))))
+	bitcoinNodesGroup.Get("/:name/support-bundle", bundle.Get(bitcoinv1alpha1.GroupVersion.WithKind("Node")))
+	bitcoinNodesGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(bitcoinv1alpha1.GroupVersion.WithKind("Node")))
+	bitcoinNodesGroup.Get("/:name/diagnostics", bitcoin.ValidateNodeExist, diagnostic.Get)
 	bitcoinNodesGroup.Get("/", bitcoin.List)
 	bitcoinNodesGroup.Head("/", bitcoin.Count)
 	bitcoinNodesGroup.Put("/:name", bitcoin.ValidateNodeExist, bitcoin.Update)
 	bitcoinNodesGroup.Delete("/:name", bitcoin.ValidateNodeExist, bitcoin.Delete)
-	bitcoinNodesGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	bitcoinNodesGroup.Get("/:name/status", websocket.New(shared.Status))
-	bitcoinNodesGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
-	bitcoinNodesGroup.Get("/:name/stats", websocket.New(bitcoin.Stats))
+	bitcoinNodesGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	bitcoinNodesGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	bitcoinNodesGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
+	bitcoinNodesGroup.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(bitcoin.Stats))
 
 	stacksGroup := v1.Group("stacks")
-	stacksNodesGroup := stacksGroup.Group("nodes")
-	stacksNodesGroup.Post("/", middleware.IsDuplicated, stacks.Create)
+	stacksNodesGroup := stacksGroup.Group("nodes", middleware.IsServed(stacksv1alpha1.GroupVersion.WithKind("Node")))
+	stacksNodesGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, stacks.Create)
 	stacksNodesGroup.Get("/:name", stacks.ValidateNodeExist, stacks.Get)
+	stacksNodesGroup.Get("/:name/manifest", manifest.Get(stacksv1alpha1.GroupVersion.WithKind("Node")))
+	stacksNodesGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(stacksv1alpha1.GroupVersion.WithKind("Node")))
+	stacksNodesGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(stacksv1alpha1.GroupVersion.WithKind("Node"))))
+	stacksNodesGroup.Get("/:name/support-bundle", bundle.Get(stacksv1alpha1.GroupVersion.WithKind("Node")))
+	stacksNodesGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(stacksv1alpha1.GroupVersion.WithKind("Node")))
+	stacksNodesGroup.Get("/:name/diagnostics", stacks.ValidateNodeExist, diagnostic.Get)
 	stacksNodesGroup.Get("/", stacks.List)
 	stacksNodesGroup.Head("/", stacks.Count)
 	stacksNodesGroup.Put("/:name", stacks.ValidateNodeExist, stacks.Update)
 	stacksNodesGroup.Delete("/:name", stacks.ValidateNodeExist, stacks.Delete)
-	stacksNodesGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	stacksNodesGroup.Get("/:name/status", websocket.New(shared.Status))
-	stacksNodesGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
+	stacksNodesGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	stacksNodesGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	stacksNodesGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
 
 	aptosGroup := v1.Group("aptos")
-	aptosNodesGroup := aptosGroup.Group("nodes")
-	aptosNodesGroup.Post("/", middleware.IsDuplicated, aptos.Create)
+	aptosNodesGroup := aptosGroup.Group("nodes", middleware.IsServed(aptosv1alpha1.GroupVersion.WithKind("Node")))
+	aptosNodesGroup.Post("/", middleware.Idempotency, middleware.IsNameAvailable, aptos.Create)
 	aptosNodesGroup.Get("/:name", aptos.ValidateNodeExist, aptos.Get)
+	aptosNodesGroup.Get("/:name/manifest", manifest.Get(aptosv1alpha1.GroupVersion.WithKind("Node")))
+	aptosNodesGroup.Get("/:name/events", middleware.Feature("events", features.Events), event.List(aptosv1alpha1.GroupVersion.WithKind("Node")))
+	aptosNodesGroup.Get("/:name/events/stream", middleware.Feature("events", features.Events), server.Websocket(event.Stream(aptosv1alpha1.GroupVersion.WithKind("Node"))))
+	aptosNodesGroup.Get("/:name/support-bundle", bundle.Get(aptosv1alpha1.GroupVersion.WithKind("Node")))
+	aptosNodesGroup.Post("/:name/clone", middleware.Idempotency, middleware.IsNameAvailable, clone.Clone(aptosv1alpha1.GroupVersion.WithKind("Node")))
+	aptosNodesGroup.Get("/:name/diagnostics", aptos.ValidateNodeExist, diagnostic.Get)
 	aptosNodesGroup.Get("/", aptos.List)
 	aptosNodesGroup.Head("/", aptos.Count)
 	aptosNodesGroup.Put("/:name", aptos.ValidateNodeExist, aptos.Update)
 	aptosNodesGroup.Delete("/:name", aptos.ValidateNodeExist, aptos.Delete)
-	aptosNodesGroup.Get("/:name/logs", websocket.New(shared.Logger))
-	aptosNodesGroup.Get("/:name/status", websocket.New(shared.Status))
-	aptosNodesGroup.Get("/:name/metrics", websocket.New(shared.Metrics))
-	aptosNodesGroup.Get("/:name/stats", websocket.New(aptos.Stats))
+	aptosNodesGroup.Get("/:name/logs", middleware.Feature("logs", features.Logs), server.Websocket(shared.Logger))
+	aptosNodesGroup.Get("/:name/status", middleware.Feature("status", features.Status), server.Websocket(shared.Status))
+	aptosNodesGroup.Get("/:name/metrics", middleware.Feature("metrics", features.Metrics), server.Websocket(shared.Metrics))
+	aptosNodesGroup.Get("/:name/stats", middleware.Feature("stats", features.Stats), server.Websocket(aptos.Stats))
 
 }
@@ -10,6 +10,7 @@ import (
 
 type AptosDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network                  aptosv1alpha1.AptosNetwork `json:"network"`
 	Image                    string                     `json:"image"`
@@ -25,7 +26,7 @@ type AptosDto struct {
 type AptosListDto []AptosDto
 
 func (dto AptosDto) FromAptosNode(n aptosv1alpha1.Node) AptosDto {
-	dto.Name = n.Name
+	dto.FromObjectMeta(n.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: n.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Image = n.Spec.Image
 	dto.Network = n.Spec.Network
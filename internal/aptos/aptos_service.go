@@ -17,17 +17,17 @@ type aptosService struct{}
 
 type IService interface {
 	// Get returns a single aptos node by name
-	Get(types.NamespacedName) (aptosv1alpha1.Node, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (aptosv1alpha1.Node, restErrors.IRestErr)
 	// List returns all aptos nodes
-	List(namespace string) (aptosv1alpha1.NodeList, restErrors.IRestErr)
+	List(ctx context.Context, namespace string) (aptosv1alpha1.NodeList, restErrors.IRestErr)
 	// Count returns all nodes length
-	Count(namespace string) (int, restErrors.IRestErr)
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 	// Create creates aptos node from the given specs
-	Create(AptosDto) (aptosv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, AptosDto) (aptosv1alpha1.Node, restErrors.IRestErr)
 	// Delete deletes aptos node by name
-	Delete(*aptosv1alpha1.Node) restErrors.IRestErr
+	Delete(context.Context, *aptosv1alpha1.Node) restErrors.IRestErr
 	// Update updates a single node by name from spec
-	Update(AptosDto, *aptosv1alpha1.Node) restErrors.IRestErr
+	Update(context.Context, AptosDto, *aptosv1alpha1.Node) restErrors.IRestErr
 }
 
 var (
@@ -38,34 +38,34 @@ func NewAptosService() IService {
 	return aptosService{}
 }
 
-func (service aptosService) Get(namespacedName types.NamespacedName) (node aptosv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service aptosService) Get(ctx context.Context, namespacedName types.NamespacedName) (node aptosv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exist", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
 	return
 }
 
-func (service aptosService) List(namespace string) (list aptosv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace))
+func (service aptosService) List(ctx context.Context, namespace string) (list aptosv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	err := k8sClient.List(ctx, &list, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.List, err)
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
 	return
 }
 
-func (service aptosService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service aptosService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &aptosv1alpha1.NodeList{}
-	err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace))
+	err := k8sClient.List(ctx, nodes, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.Count, err)
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -73,19 +73,19 @@ func (service aptosService) Count(namespace string) (count int, restErr restErro
 	return len(nodes.Items), nil
 }
 
-func (service aptosService) Create(dto AptosDto) (node aptosv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service aptosService) Create(ctx context.Context, dto AptosDto) (node aptosv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	k8s.DefaultResources(&node.Spec.Resources)
 	node.Spec.Network = dto.Network
 	node.Spec.Image = dto.Image
 	node.Spec.API = true
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %s already exist", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -93,7 +93,11 @@ func (service aptosService) Create(dto AptosDto) (node aptosv1alpha1.Node, restE
 	return
 }
 
-func (service aptosService) Update(dto AptosDto, node *aptosv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service aptosService) Update(ctx context.Context, dto AptosDto, node *aptosv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.Image != "" {
 		node.Spec.Image = dto.Image
 	}
@@ -141,25 +145,25 @@ func (service aptosService) Update(dto AptosDto, node *aptosv1alpha1.Node) (rest
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -168,9 +172,9 @@ func (service aptosService) Update(dto AptosDto, node *aptosv1alpha1.Node) (rest
 	return
 }
 
-func (service aptosService) Delete(node *aptosv1alpha1.Node) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), node); err != nil {
-		go logger.Error(service.Delete, err)
+func (service aptosService) Delete(ctx context.Context, node *aptosv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 		return
 	}
@@ -21,6 +21,7 @@ type RPCUser struct {
 
 type BitcoinDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Image            string                         `json:"image"`
 	Network          bitcoinv1alpha1.BitcoinNetwork `json:"network"`
@@ -36,7 +37,7 @@ type BitcoinDto struct {
 type BitcoinListDto []BitcoinDto
 
 func (dto BitcoinDto) FromBitcoinNode(n bitcoinv1alpha1.Node) BitcoinDto {
-	dto.Name = n.Name
+	dto.FromObjectMeta(n.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: n.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Image = n.Spec.Image
 	dto.Network = n.Spec.Network
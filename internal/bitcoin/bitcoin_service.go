@@ -16,12 +16,12 @@ import (
 type bitcoinService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (bitcoinv1alpha1.Node, restErrors.IRestErr)
-	List(namespace string) (bitcoinv1alpha1.NodeList, restErrors.IRestErr)
-	Count(namespace string) (int, restErrors.IRestErr)
-	Create(BitcoinDto) (bitcoinv1alpha1.Node, restErrors.IRestErr)
-	Delete(*bitcoinv1alpha1.Node) restErrors.IRestErr
-	Update(BitcoinDto, *bitcoinv1alpha1.Node) restErrors.IRestErr
+	Get(context.Context, types.NamespacedName) (bitcoinv1alpha1.Node, restErrors.IRestErr)
+	List(ctx context.Context, namespace string) (bitcoinv1alpha1.NodeList, restErrors.IRestErr)
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
+	Create(context.Context, BitcoinDto) (bitcoinv1alpha1.Node, restErrors.IRestErr)
+	Delete(context.Context, *bitcoinv1alpha1.Node) restErrors.IRestErr
+	Update(context.Context, BitcoinDto, *bitcoinv1alpha1.Node) restErrors.IRestErr
 }
 
 var (
@@ -33,13 +33,13 @@ func NewBitcoinService() IService {
 }
 
 // Get returns a single bitcoin node by name
-func (service bitcoinService) Get(namespacedName types.NamespacedName) (node bitcoinv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service bitcoinService) Get(ctx context.Context, namespacedName types.NamespacedName) (node bitcoinv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exist", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
@@ -47,10 +47,10 @@ func (service bitcoinService) Get(namespacedName types.NamespacedName) (node bit
 }
 
 // List returns all bitcoin nodes
-func (service bitcoinService) List(namespace string) (list bitcoinv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace))
+func (service bitcoinService) List(ctx context.Context, namespace string) (list bitcoinv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	err := k8sClient.List(ctx, &list, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.List, err)
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -58,11 +58,11 @@ func (service bitcoinService) List(namespace string) (list bitcoinv1alpha1.NodeL
 }
 
 // Count returns all nodes length
-func (service bitcoinService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service bitcoinService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &bitcoinv1alpha1.NodeList{}
-	err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace))
+	err := k8sClient.List(ctx, nodes, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.Count, err)
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -71,7 +71,7 @@ func (service bitcoinService) Count(namespace string) (count int, restErr restEr
 }
 
 // Create creates bitcoin node from the given specs
-func (service bitcoinService) Create(dto BitcoinDto) (node bitcoinv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service bitcoinService) Create(ctx context.Context, dto BitcoinDto) (node bitcoinv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = bitcoinv1alpha1.NodeSpec{
 		Network: dto.Network,
@@ -86,12 +86,12 @@ func (service bitcoinService) Create(dto BitcoinDto) (node bitcoinv1alpha1.Node,
 
 	k8s.DefaultResources(&node.Spec.Resources)
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %s already exist", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -100,7 +100,11 @@ func (service bitcoinService) Create(dto BitcoinDto) (node bitcoinv1alpha1.Node,
 }
 
 // Update updates a single node by name from spec
-func (service bitcoinService) Update(dto BitcoinDto, node *bitcoinv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service bitcoinService) Update(ctx context.Context, dto BitcoinDto, node *bitcoinv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.Image != "" {
 		node.Spec.Image = dto.Image
 	}
@@ -149,25 +153,25 @@ func (service bitcoinService) Update(dto BitcoinDto, node *bitcoinv1alpha1.Node)
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -177,9 +181,9 @@ func (service bitcoinService) Update(dto BitcoinDto, node *bitcoinv1alpha1.Node)
 }
 
 // Delete deletes bitcoin node by name
-func (service bitcoinService) Delete(node *bitcoinv1alpha1.Node) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), node); err != nil {
-		go logger.Error(service.Delete, err)
+func (service bitcoinService) Delete(ctx context.Context, node *bitcoinv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 		return
 	}
@@ -0,0 +1,48 @@
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"path"
+	"time"
+)
+
+// BundleDto is the support bundle of a resource, its files are written under a directory named after it
+type BundleDto struct {
+	Name      string
+	CreatedAt time.Time
+	Files     []FileDto
+}
+
+// FileDto is a file of a support bundle
+type FileDto struct {
+	Name string
+	Data []byte
+}
+
+// Write writes the bundle to w as a tar.gz archive
+func (bundle BundleDto) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+
+	for _, file := range bundle.Files {
+		header := &tar.Header{
+			Name:    path.Join(bundle.Name, file.Name),
+			Mode:    0644,
+			Size:    int64(len(file.Data)),
+			ModTime: bundle.CreatedAt,
+		}
+		if err := archive.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := archive.Write(file.Data); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
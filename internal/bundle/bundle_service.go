@@ -0,0 +1,229 @@
+// Package bundle internal is the domain layer for support bundles
+// collects everything needed to report an issue with a resource, with the values of its secrets redacted
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kotalco/community-api/internal/event"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/logger"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// logLines is the number of lines of the logs of every container
+	logLines = int64(1000)
+	// logBytes caps the logs of every container
+	logBytes = int64(1024 * 1024)
+)
+
+type bundleService struct{}
+
+type IService interface {
+	Get(ctx context.Context, kind k8s.Kind, name types.NamespacedName) (BundleDto, restErrors.IRestErr)
+}
+
+var (
+	k8sClient          = k8s.NewClientService()
+	eventService       = event.NewEventService()
+	statefulSetService = statefulset.NewService()
+)
+
+func NewBundleService() IService {
+	return bundleService{}
+}
+
+// collector adds the files of a bundle with the secret values redacted
+// failures to collect a file are recorded in errors.txt instead of failing the bundle
+type collector struct {
+	ctx      context.Context
+	bundle   BundleDto
+	redactor k8s.Redactor
+	errors   []string
+}
+
+// Get returns the support bundle of a resource of kind by name: its manifest, the statefulset, service, volume and config
+// the kotal operator created for it, its status, events, the current and previous logs of its containers and a metrics snapshot
+// the values of the secrets referenced by the resource are redacted from every file
+func (service bundleService) Get(ctx context.Context, kind k8s.Kind, name types.NamespacedName) (BundleDto, restErrors.IRestErr) {
+	obj := kind.NewObject()
+	if err := k8sClient.Get(ctx, name, obj); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return BundleDto{}, restErrors.NewNotFoundError(fmt.Sprintf("%s by name %s doesn't exist", kind.Kind, name.Name))
+		}
+		logger.ErrorContext(ctx, service.Get, err)
+		return BundleDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't get %s by name %s", kind.Kind, name.Name))
+	}
+
+	c := &collector{ctx: ctx, bundle: BundleDto{Name: name.Name, CreatedAt: time.Now().UTC(), Files: make([]FileDto, 0)}}
+	c.redactor = k8s.NewRedactor(c.secrets(kind, obj))
+	c.addObject("resource.yaml", obj)
+
+	sts := &appsv1.StatefulSet{}
+	if c.get("statefulset.yaml", name, sts) {
+		k8s.RedactEnv(&sts.Spec.Template.Spec)
+		c.addObject("statefulset.yaml", sts)
+	}
+	if svc := (&corev1.Service{}); c.get("service.yaml", name, svc) {
+		c.addObject("service.yaml", svc)
+	}
+	if pvc := (&corev1.PersistentVolumeClaim{}); c.get("persistentvolumeclaim.yaml", name, pvc) {
+		c.addObject("persistentvolumeclaim.yaml", pvc)
+	}
+	if config := (&corev1.ConfigMap{}); c.get("configmap.yaml", name, config) {
+		c.addObject("configmap.yaml", config)
+		keys := make([]string, 0, len(config.Data))
+		for key := range config.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			c.add("config/"+key, []byte(config.Data[key]))
+		}
+	}
+
+	if status, restErr := statefulSetService.Status(ctx, name); restErr != nil {
+		c.fail("status.json", restErr)
+	} else {
+		c.addJSON("status.json", status)
+	}
+	if events, restErr := eventService.List(ctx, kind, name); restErr != nil {
+		c.fail("events.json", restErr)
+	} else {
+		c.addJSON("events.json", events)
+	}
+
+	pod := &corev1.Pod{}
+	if c.get("pod.yaml", types.NamespacedName{Namespace: name.Namespace, Name: fmt.Sprintf("%s-0", name.Name)}, pod) {
+		k8s.RedactEnv(&pod.Spec)
+		c.addObject("pod.yaml", pod)
+		c.addLogs(pod)
+		c.addMetrics(pod)
+	}
+
+	if len(c.errors) > 0 {
+		c.add("errors.txt", []byte(strings.Join(c.errors, "\n")+"\n"))
+	}
+	return c.bundle, nil
+}
+
+// secrets returns the secrets referenced by the resource, their values are redacted
+func (c *collector) secrets(kind k8s.Kind, obj client.Object) []corev1.Secret {
+	manifest, err := k8s.Manifest(kind, obj)
+	if err != nil {
+		c.fail("secrets", err)
+		return nil
+	}
+
+	secrets := make([]corev1.Secret, 0)
+	for _, name := range k8s.ReferencedSecrets(manifest) {
+		secret := corev1.Secret{}
+		if c.get("secrets", types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}, &secret) {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+// get gets obj by name for file, objects that don't exist are skipped
+func (c *collector) get(file string, name types.NamespacedName, obj client.Object) bool {
+	err := k8sClient.Get(c.ctx, name, obj)
+	if err == nil {
+		return true
+	}
+	if !apiErrors.IsNotFound(err) {
+		logger.ErrorContext(c.ctx, c.get, err)
+		c.fail(file, err)
+	}
+	return false
+}
+
+func (c *collector) add(file string, data []byte) {
+	c.bundle.Files = append(c.bundle.Files, FileDto{Name: file, Data: c.redactor.Redact(data)})
+}
+
+func (c *collector) addObject(file string, obj client.Object) {
+	if gvk, err := apiutil.GVKForObject(obj, k8s.RunTimeScheme); err == nil {
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	obj.SetManagedFields(nil)
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		c.fail(file, err)
+		return
+	}
+	c.add(file, data)
+}
+
+func (c *collector) addJSON(file string, value interface{}) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		c.fail(file, err)
+		return
+	}
+	c.add(file, data)
+}
+
+// addLogs adds the current logs of the containers of pod, and their previous logs if they were restarted
+func (c *collector) addLogs(pod *corev1.Pod) {
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		c.addLog(pod, status.Name, false)
+		if status.LastTerminationState.Terminated != nil {
+			c.addLog(pod, status.Name, true)
+		}
+	}
+}
+
+func (c *collector) addLog(pod *corev1.Pod, container string, previous bool) {
+	file := fmt.Sprintf("logs/%s.log", container)
+	if previous {
+		file = fmt.Sprintf("logs/%s.previous.log", container)
+	}
+
+	lines, bytes := logLines, logBytes
+	opts := &corev1.PodLogOptions{Container: container, Previous: previous, TailLines: &lines, LimitBytes: &bytes}
+	logs, err := k8s.Clientset().CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).DoRaw(c.ctx)
+	if err != nil {
+		c.fail(file, err)
+		return
+	}
+	c.add(file, logs)
+}
+
+// addMetrics adds a snapshot of the cpu and memory usage of the containers of pod, metrics are optional
+func (c *collector) addMetrics(pod *corev1.Pod) {
+	metricsClientset := k8s.MetricsClientset()
+	if metricsClientset == nil || !k8s.Discovery().IsServed(k8s.PodMetricsResource) {
+		c.errors = append(c.errors, "metrics.json: metrics api isn't available, metrics-server isn't installed in the cluster")
+		return
+	}
+
+	metrics, err := metricsClientset.MetricsV1beta1().PodMetricses(pod.Namespace).Get(c.ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		c.fail("metrics.json", err)
+		return
+	}
+	metrics.ManagedFields = nil
+	c.addJSON("metrics.json", metrics)
+}
+
+func (c *collector) fail(file string, err error) {
+	c.errors = append(c.errors, fmt.Sprintf("%s: %s", file, string(c.redactor.Redact([]byte(err.Error())))))
+}
@@ -0,0 +1,133 @@
+package capabilities
+
+import (
+	"github.com/kotalco/community-api/pkg/k8s"
+	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
+	bitcoinv1alpha1 "github.com/kotalco/kotal/apis/bitcoin/v1alpha1"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	filecoinv1alpha1 "github.com/kotalco/kotal/apis/filecoin/v1alpha1"
+	stacksv1alpha1 "github.com/kotalco/kotal/apis/stacks/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Protocol is a kotal protocol api group with the resources, networks and clients it supports
+type Protocol struct {
+	Name         string
+	GroupVersion schema.GroupVersion
+	Resources    []string
+	Networks     []string
+	Clients      []string
+}
+
+// support is the networks and clients of a protocol
+type support struct {
+	Networks []string
+	Clients  []string
+}
+
+// supports are the networks and clients of every protocol by protocol name
+var supports = map[string]support{
+	"aptos": {
+		Networks: []string{string(aptosv1alpha1.Mainnet), string(aptosv1alpha1.Testnet), string(aptosv1alpha1.Devnet)},
+		Clients:  []string{"aptos-core"},
+	},
+	"bitcoin": {
+		Networks: []string{string(bitcoinv1alpha1.Mainnet), string(bitcoinv1alpha1.Testnet)},
+		Clients:  []string{"bitcoin-core"},
+	},
+	"chainlink": {
+		Clients: []string{"chainlink"},
+	},
+	"ethereum": {
+		Networks: []string{
+			ethereumv1alpha1.MainNetwork,
+			ethereumv1alpha1.GoerliNetwork,
+			ethereumv1alpha1.SepoliaNetwork,
+			ethereumv1alpha1.RopstenNetwork,
+			ethereumv1alpha1.RinkebyNetwork,
+			ethereumv1alpha1.XDaiNetwork,
+			ethereumv1alpha1.KottiNetwork,
+			ethereumv1alpha1.ClassicNetwork,
+			ethereumv1alpha1.MordorNetwork,
+			ethereumv1alpha1.DevNetwork,
+		},
+		Clients: []string{
+			string(ethereumv1alpha1.BesuClient),
+			string(ethereumv1alpha1.GethClient),
+			string(ethereumv1alpha1.NethermindClient),
+		},
+	},
+	"ethereum2": {
+		Networks: []string{"mainnet", "goerli", "sepolia"},
+		Clients: []string{
+			string(ethereum2v1alpha1.TekuClient),
+			string(ethereum2v1alpha1.PrysmClient),
+			string(ethereum2v1alpha1.LighthouseClient),
+			string(ethereum2v1alpha1.NimbusClient),
+		},
+	},
+	"filecoin": {
+		Networks: []string{string(filecoinv1alpha1.MainNetwork), string(filecoinv1alpha1.CalibrationNetwork)},
+		Clients:  []string{"lotus"},
+	},
+	"ipfs": {
+		Clients: []string{"kubo", "ipfs-cluster-service"},
+	},
+	"near": {
+		Networks: []string{"mainnet", "testnet", "betanet"},
+		Clients:  []string{"nearcore"},
+	},
+	"polkadot": {
+		Networks: []string{"polkadot", "kusama", "rococo", "westend"},
+		Clients:  []string{"polkadot"},
+	},
+	"stacks": {
+		Networks: []string{string(stacksv1alpha1.Mainnet), string(stacksv1alpha1.Testnet)},
+		Clients:  []string{"stacks-node"},
+	},
+}
+
+// Protocols returns the catalog of every protocol mapped by the api server, built from k8s.KotalKinds in their order
+// Name matches the protocol url group, Resources match the resource url groups
+func Protocols() []Protocol {
+	protocols := make([]Protocol, 0)
+	index := map[string]int{}
+	for _, kind := range k8s.KotalKinds {
+		i, ok := index[kind.Protocol]
+		if !ok {
+			i = len(protocols)
+			index[kind.Protocol] = i
+			protocols = append(protocols, Protocol{
+				Name:         kind.Protocol,
+				GroupVersion: kind.GroupVersion(),
+				Networks:     supports[kind.Protocol].Networks,
+				Clients:      supports[kind.Protocol].Clients,
+			})
+		}
+		protocols[i].Resources = append(protocols[i].Resources, kind.Resource)
+	}
+	return protocols
+}
+
+// ResourceDto is a protocol resource and whether the cluster serves it
+type ResourceDto struct {
+	Name      string `json:"name"`
+	Installed bool   `json:"installed"`
+}
+
+// ProtocolDto is a protocol capability as reported to the dashboard
+type ProtocolDto struct {
+	Name      string        `json:"name"`
+	Group     string        `json:"group"`
+	Version   string        `json:"version"`
+	Installed bool          `json:"installed"`
+	Resources []ResourceDto `json:"resources"`
+	Networks  []string      `json:"networks,omitempty"`
+	Clients   []string      `json:"clients,omitempty"`
+}
+
+type CapabilitiesDto struct {
+	Protocols     []ProtocolDto `json:"protocols"`
+	LastDiscovery string        `json:"lastDiscovery,omitempty"`
+}
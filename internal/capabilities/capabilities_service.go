@@ -0,0 +1,49 @@
+// Package capabilities internal is the domain layer for the protocols the cluster can serve
+// uses the k8s discovery cache to report installed kotal crds
+package capabilities
+
+import (
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/shared"
+)
+
+type capabilitiesService struct{}
+
+type IService interface {
+	// Get returns every known protocol and whether the cluster serves it
+	Get() CapabilitiesDto
+}
+
+var discovery = k8s.Discovery()
+
+func NewCapabilitiesService() IService {
+	return capabilitiesService{}
+}
+
+// Get returns every known protocol and whether the cluster serves it
+func (service capabilitiesService) Get() (dto CapabilitiesDto) {
+	protocols := Protocols()
+	dto.Protocols = make([]ProtocolDto, len(protocols))
+	for i, protocol := range protocols {
+		protocolDto := ProtocolDto{
+			Name:      protocol.Name,
+			Group:     protocol.GroupVersion.Group,
+			Version:   protocol.GroupVersion.Version,
+			Networks:  protocol.Networks,
+			Clients:   protocol.Clients,
+			Resources: make([]ResourceDto, len(protocol.Resources)),
+		}
+		for j, resource := range protocol.Resources {
+			installed := discovery.IsServed(protocol.GroupVersion.WithResource(resource))
+			protocolDto.Resources[j] = ResourceDto{Name: resource, Installed: installed}
+			protocolDto.Installed = protocolDto.Installed || installed
+		}
+		dto.Protocols[i] = protocolDto
+	}
+
+	if lastRefresh := discovery.LastRefresh(); !lastRefresh.IsZero() {
+		dto.LastDiscovery = lastRefresh.UTC().Format(shared.JavascriptISOString)
+	}
+
+	return
+}
@@ -15,6 +15,7 @@ type apiCredentials struct {
 
 type ChainlinkDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	EthereumChainId            uint            `json:"ethereumChainId"`
 	LinkContractAddress        string          `json:"linkContractAddress"`
@@ -38,7 +39,7 @@ type ChainlinkDto struct {
 type ChainlinkListDto []ChainlinkDto
 
 func (dto ChainlinkDto) FromChainlinkNode(n chainlinkv1alpha1.Node) ChainlinkDto {
-	dto.Name = n.Name
+	dto.FromObjectMeta(n.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: n.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.EthereumChainId = n.Spec.EthereumChainId
 	dto.LinkContractAddress = n.Spec.LinkContractAddress
@@ -20,12 +20,12 @@ import (
 type chainlinkService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (chainlinkv1alpha1.Node, restErrors.IRestErr)
-	Create(ChainlinkDto) (chainlinkv1alpha1.Node, restErrors.IRestErr)
-	Update(ChainlinkDto, *chainlinkv1alpha1.Node) restErrors.IRestErr
-	List(namespace string) (chainlinkv1alpha1.NodeList, restErrors.IRestErr)
-	Count(namespace string) (int, restErrors.IRestErr)
-	Delete(*chainlinkv1alpha1.Node) restErrors.IRestErr
+	Get(context.Context, types.NamespacedName) (chainlinkv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, ChainlinkDto) (chainlinkv1alpha1.Node, restErrors.IRestErr)
+	Update(context.Context, ChainlinkDto, *chainlinkv1alpha1.Node) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (chainlinkv1alpha1.NodeList, restErrors.IRestErr)
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
+	Delete(context.Context, *chainlinkv1alpha1.Node) restErrors.IRestErr
 }
 
 var (
@@ -37,13 +37,13 @@ func NewChainLinkService() IService {
 }
 
 // Get returns a single chainlink node by name
-func (service chainlinkService) Get(namespacedName types.NamespacedName) (node chainlinkv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service chainlinkService) Get(ctx context.Context, namespacedName types.NamespacedName) (node chainlinkv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exist", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
@@ -52,7 +52,7 @@ func (service chainlinkService) Get(namespacedName types.NamespacedName) (node c
 }
 
 // Create creates chainlink node from the given spec
-func (service chainlinkService) Create(dto ChainlinkDto) (node chainlinkv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service chainlinkService) Create(ctx context.Context, dto ChainlinkDto) (node chainlinkv1alpha1.Node, restErr restErrors.IRestErr) {
 
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = chainlinkv1alpha1.NodeSpec{
@@ -74,13 +74,13 @@ func (service chainlinkService) Create(dto ChainlinkDto) (node chainlinkv1alpha1
 		node.Default()
 	}
 
-	err := k8sClient.Create(context.Background(), &node)
+	err := k8sClient.Create(ctx, &node)
 	if err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %s already exist", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -89,7 +89,10 @@ func (service chainlinkService) Create(dto ChainlinkDto) (node chainlinkv1alpha1
 }
 
 // Update updates a single chainlink node by name from spec
-func (service chainlinkService) Update(dto ChainlinkDto, node *chainlinkv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service chainlinkService) Update(ctx context.Context, dto ChainlinkDto, node *chainlinkv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
 
 	if dto.EthereumWSEndpoint != "" {
 		node.Spec.EthereumWSEndpoint = dto.EthereumWSEndpoint
@@ -173,25 +176,25 @@ func (service chainlinkService) Update(dto ChainlinkDto, node *chainlinkv1alpha1
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -201,10 +204,10 @@ func (service chainlinkService) Update(dto ChainlinkDto, node *chainlinkv1alpha1
 }
 
 // List returns all chainlink nodes
-func (service chainlinkService) List(namespace string) (list chainlinkv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace))
+func (service chainlinkService) List(ctx context.Context, namespace string) (list chainlinkv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	err := k8sClient.List(ctx, &list, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.List, err)
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -213,11 +216,11 @@ func (service chainlinkService) List(namespace string) (list chainlinkv1alpha1.N
 }
 
 // Count returns all nodes length
-func (service chainlinkService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service chainlinkService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &chainlinkv1alpha1.NodeList{}
-	err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace))
+	err := k8sClient.List(ctx, nodes, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.Count, err)
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to count all nodes")
 		return
 	}
@@ -226,11 +229,11 @@ func (service chainlinkService) Count(namespace string) (count int, restErr rest
 }
 
 // Delete a single chainlink node by name
-func (service chainlinkService) Delete(node *chainlinkv1alpha1.Node) (restErr restErrors.IRestErr) {
-	err := k8sClient.Delete(context.Background(), node)
+func (service chainlinkService) Delete(ctx context.Context, node *chainlinkv1alpha1.Node) (restErr restErrors.IRestErr) {
+	err := k8sClient.Delete(ctx, node)
 
 	if err != nil {
-		go logger.Error(service.Delete, err)
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 	}
 	return
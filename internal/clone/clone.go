@@ -0,0 +1,50 @@
+package clone
+
+import (
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+)
+
+// secret policies for the secrets referenced by the source resource, identity secrets are never shared nor duplicated
+const (
+	SecretsShare     = "share"
+	SecretsDuplicate = "duplicate"
+)
+
+// actions taken for the secrets referenced by the source resource
+const (
+	SecretShared      = "shared"
+	SecretDuplicated  = "duplicated"
+	SecretReplaced    = "replaced"
+	SecretRegenerated = "regenerated"
+)
+
+// CloneDto is a copy of a resource under a new name with optional overrides
+type CloneDto struct {
+	Name      string               `json:"name"`
+	Namespace string               `json:"-"`
+	Network   string               `json:"network"`
+	Image     string               `json:"image"`
+	Resources *sharedAPI.Resources `json:"resources"`
+	// Secrets is the policy for the secrets referenced by the source, share by default
+	Secrets string `json:"secrets"`
+	// SecretOverrides maps secrets referenced by the source to existing secrets to reference instead
+	SecretOverrides map[string]string `json:"secretOverrides"`
+}
+
+// CloneResultDto is the created copy and what happened to the secrets referenced by the source
+type CloneResultDto struct {
+	Kind    string            `json:"kind"`
+	Name    string            `json:"name"`
+	Source  string            `json:"source"`
+	Link    string            `json:"link"`
+	Secrets []ClonedSecretDto `json:"secrets"`
+}
+
+// ClonedSecretDto is a secret referenced by the source and the secret referenced by the copy instead
+// Secret is empty if the identity secret has been removed for the node to generate a new key
+type ClonedSecretDto struct {
+	Field  string `json:"field"`
+	Source string `json:"source"`
+	Secret string `json:"secret,omitempty"`
+	Action string `json:"action"`
+}
@@ -0,0 +1,221 @@
+// Package clone internal is the domain layer for cloning resources
+// copies the spec of a resource of any protocol under a new name
+package clone
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kotalco/community-api/internal/template"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type cloneService struct{}
+
+type IService interface {
+	Clone(ctx context.Context, kind k8s.Kind, source string, dto CloneDto) (CloneResultDto, restErrors.IRestErr)
+}
+
+var (
+	k8sClient = k8s.NewClientService()
+)
+
+func NewCloneService() IService {
+	return cloneService{}
+}
+
+// Clone creates a copy of the source resource of kind named dto.Name
+// identity secrets are replaced by their override or removed for the copy to generate new keys
+// other secrets are shared or duplicated, duplicated secrets are deleted if the copy can't be created
+func (service cloneService) Clone(ctx context.Context, kind k8s.Kind, source string, dto CloneDto) (CloneResultDto, restErrors.IRestErr) {
+	obj := kind.NewObject()
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: dto.Namespace, Name: source}, obj); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return CloneResultDto{}, restErrors.NewNotFoundError(fmt.Sprintf("%s by name %s doesn't exist", kind.Kind, source))
+		}
+		logger.ErrorContext(ctx, service.Clone, err)
+		return CloneResultDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't get %s by name %s", kind.Kind, source))
+	}
+
+	manifest, err := k8s.Manifest(kind, obj)
+	if err != nil {
+		logger.ErrorContext(ctx, service.Clone, err)
+		return CloneResultDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't clone %s by name %s", kind.Kind, source))
+	}
+	clone := &unstructured.Unstructured{Object: manifest}
+	clone.SetName(dto.Name)
+	clone.SetNamespace(dto.Namespace)
+	labels := clone.GetLabels()
+	delete(labels, template.StackLabel)
+	delete(labels, template.TemplateLabel)
+	clone.SetLabels(labels)
+
+	if restErr := override(kind, manifest, dto); restErr != nil {
+		return CloneResultDto{}, restErr
+	}
+
+	result := CloneResultDto{
+		Kind:    kind.Kind,
+		Name:    dto.Name,
+		Source:  source,
+		Link:    fmt.Sprintf("/api/v1/%s/%s/%s", kind.Protocol, kind.Resource, dto.Name),
+		Secrets: make([]ClonedSecretDto, 0),
+	}
+	k8s.ReplaceSecrets(manifest, func(field, secret string) string {
+		cloned := ClonedSecretDto{Field: field, Source: secret}
+		switch replaced, ok := dto.SecretOverrides[secret]; {
+		case ok:
+			cloned.Secret, cloned.Action = replaced, SecretReplaced
+		case k8s.IsIdentitySecret(field):
+			cloned.Action = SecretRegenerated
+		case dto.Secrets == SecretsDuplicate:
+			cloned.Secret, cloned.Action = fmt.Sprintf("%s-%s", dto.Name, secret), SecretDuplicated
+		default:
+			cloned.Secret, cloned.Action = secret, SecretShared
+		}
+		result.Secrets = append(result.Secrets, cloned)
+		return cloned.Secret
+	})
+	sort.Slice(result.Secrets, func(i, j int) bool { return result.Secrets[i].Source < result.Secrets[j].Source })
+
+	if restErr := service.validateOverrides(ctx, dto); restErr != nil {
+		return CloneResultDto{}, restErr
+	}
+
+	duplicated, restErr := service.duplicateSecrets(ctx, dto, result.Secrets)
+	if restErr != nil {
+		return CloneResultDto{}, restErr
+	}
+
+	if err = k8sClient.Create(ctx, clone); err != nil {
+		logger.ErrorContext(ctx, service.Clone, err)
+		service.rollback(ctx, duplicated)
+
+		message := fmt.Sprintf("can't clone %s by name %s", kind.Kind, source)
+		if apiErrors.IsInvalid(err) || apiErrors.IsBadRequest(err) {
+			return CloneResultDto{}, restErrors.NewBadRequestError(fmt.Sprintf("%s: %s, identity secrets can be replaced with secretOverrides", message, err.Error()))
+		}
+		if apiErrors.IsAlreadyExists(err) {
+			return CloneResultDto{}, restErrors.NewConflictError(fmt.Sprintf("resource %s already exists!", dto.Name))
+		}
+		return CloneResultDto{}, restErrors.NewInternalServerError(message)
+	}
+
+	return result, nil
+}
+
+// override sets the network, image and resources overrides of dto in the manifest spec
+func override(kind k8s.Kind, manifest map[string]interface{}, dto CloneDto) restErrors.IRestErr {
+	spec, _ := manifest["spec"].(map[string]interface{})
+	if spec == nil {
+		spec = map[string]interface{}{}
+		manifest["spec"] = spec
+	}
+
+	if dto.Network != "" {
+		if _, ok := spec["network"]; !ok {
+			return restErrors.NewBadRequestError(fmt.Sprintf("%s doesn't have a network", kind.Kind))
+		}
+		spec["network"] = dto.Network
+	}
+	if dto.Image != "" {
+		spec["image"] = dto.Image
+	}
+	if dto.Resources != nil {
+		overrides, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dto.Resources)
+		if err != nil {
+			return restErrors.NewBadRequestError("invalid resources")
+		}
+		resources, _ := spec["resources"].(map[string]interface{})
+		if resources == nil {
+			resources = map[string]interface{}{}
+			spec["resources"] = resources
+		}
+		for key, value := range overrides {
+			resources[key] = value
+		}
+	}
+	return nil
+}
+
+// validateOverrides checks the secrets of the overrides exist
+func (service cloneService) validateOverrides(ctx context.Context, dto CloneDto) restErrors.IRestErr {
+	for source, secret := range dto.SecretOverrides {
+		if secret == source {
+			return restErrors.NewBadRequestError(fmt.Sprintf("secret %s can't override itself", source))
+		}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: dto.Namespace, Name: secret}, &corev1.Secret{}); err != nil {
+			if apiErrors.IsNotFound(err) {
+				return restErrors.NewBadRequestError(fmt.Sprintf("secret by name %s doesn't exist", secret))
+			}
+			logger.ErrorContext(ctx, service.validateOverrides, err)
+			return restErrors.NewInternalServerError(fmt.Sprintf("can't get secret by name %s", secret))
+		}
+	}
+	return nil
+}
+
+// duplicateSecrets creates the copies of the duplicated secrets, the copies created are deleted if one of them fails
+func (service cloneService) duplicateSecrets(ctx context.Context, dto CloneDto, secrets []ClonedSecretDto) ([]client.Object, restErrors.IRestErr) {
+	created := make([]client.Object, 0)
+	seen := map[string]bool{}
+	for _, cloned := range secrets {
+		if cloned.Action != SecretDuplicated || seen[cloned.Source] {
+			continue
+		}
+		seen[cloned.Source] = true
+
+		source := corev1.Secret{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: dto.Namespace, Name: cloned.Source}, &source); err != nil {
+			service.rollback(ctx, created)
+			if apiErrors.IsNotFound(err) {
+				return nil, restErrors.NewBadRequestError(fmt.Sprintf("secret by name %s doesn't exist", cloned.Source))
+			}
+			logger.ErrorContext(ctx, service.duplicateSecrets, err)
+			return nil, restErrors.NewInternalServerError(fmt.Sprintf("can't get secret by name %s", cloned.Source))
+		}
+
+		t := true
+		secret := &corev1.Secret{
+			Type:      source.Type,
+			Data:      source.Data,
+			Immutable: &t,
+		}
+		secret.Name = cloned.Secret
+		secret.Namespace = dto.Namespace
+		secret.Labels = source.Labels
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels["app.kubernetes.io/created-by"] = "kotal-api"
+
+		if err := k8sClient.Create(ctx, secret); err != nil {
+			service.rollback(ctx, created)
+			if apiErrors.IsAlreadyExists(err) {
+				return nil, restErrors.NewConflictError(fmt.Sprintf("secret by name %s already exist", cloned.Secret))
+			}
+			logger.ErrorContext(ctx, service.duplicateSecrets, err)
+			return nil, restErrors.NewInternalServerError(fmt.Sprintf("can't duplicate secret %s", cloned.Source))
+		}
+		created = append(created, secret)
+	}
+	return created, nil
+}
+
+// rollback deletes the created objects, failures are logged and skipped
+func (service cloneService) rollback(ctx context.Context, created []client.Object) {
+	for _, obj := range created {
+		if err := k8sClient.Delete(ctx, obj); err != nil && !apiErrors.IsNotFound(err) {
+			logger.ErrorContext(ctx, service.rollback, err)
+		}
+	}
+}
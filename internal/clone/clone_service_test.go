@@ -0,0 +1,160 @@
+package clone
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kotalco/community-api/internal/testutil"
+	"github.com/kotalco/community-api/pkg/k8s"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func secret(name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Data:       map[string][]byte{"key": []byte(name + "-value")},
+	}
+}
+
+// newClient returns a fake client with a geth node referencing an identity secret, its node key
+// and the jwt, account key and account password secrets
+func newClient(t *testing.T) *testutil.RecordingClient {
+	node := &ethereumv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "geth", Namespace: "default"},
+		Spec: ethereumv1alpha1.NodeSpec{
+			Network:                  ethereumv1alpha1.GoerliNetwork,
+			Client:                   ethereumv1alpha1.GethClient,
+			NodePrivateKeySecretName: "geth-nodekey",
+			JWTSecretName:            "geth-jwt",
+			Import: &ethereumv1alpha1.ImportedAccount{
+				PrivateKeySecretName: "geth-account-key",
+				PasswordSecretName:   "geth-account-password",
+			},
+		},
+	}
+	objects := []client.Object{node, secret("geth-nodekey"), secret("geth-jwt"), secret("geth-account-key"), secret("geth-account-password"), secret("other-nodekey")}
+
+	recording := testutil.NewClient(t, objects...)
+	k8sClient = recording
+	return recording
+}
+
+func getNode(t *testing.T, c client.Client, name string) *ethereumv1alpha1.Node {
+	node := &ethereumv1alpha1.Node{}
+	assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: name}, node))
+	return node
+}
+
+func TestClone(t *testing.T) {
+	ctx := context.Background()
+	kind, _ := k8s.KindFor(ethereumv1alpha1.GroupVersion.WithKind("Node"))
+
+	t.Run("identity secrets aren't shared by default", func(t *testing.T) {
+		recording := newClient(t)
+		result, err := NewCloneService().Clone(ctx, kind, "geth", CloneDto{Name: "geth-copy", Namespace: "default"})
+		assert.Nil(t, err)
+		assert.Equal(t, []ClonedSecretDto{
+			{Field: "privateKeySecretName", Source: "geth-account-key", Action: SecretRegenerated},
+			{Field: "passwordSecretName", Source: "geth-account-password", Secret: "geth-account-password", Action: SecretShared},
+			{Field: "jwtSecretName", Source: "geth-jwt", Secret: "geth-jwt", Action: SecretShared},
+			{Field: "nodePrivateKeySecretName", Source: "geth-nodekey", Action: SecretRegenerated},
+		}, result.Secrets)
+		assert.Equal(t, []string{"geth-copy"}, recording.Created)
+
+		copied := getNode(t, recording, "geth-copy")
+		assert.Equal(t, "", copied.Spec.NodePrivateKeySecretName)
+		assert.Equal(t, "geth-jwt", copied.Spec.JWTSecretName)
+		assert.Equal(t, ethereumv1alpha1.GoerliNetwork, copied.Spec.Network)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		recording := newClient(t)
+		result, err := NewCloneService().Clone(ctx, kind, "geth", CloneDto{Name: "geth-copy", Namespace: "default", Secrets: SecretsDuplicate})
+		assert.Nil(t, err)
+		for _, cloned := range result.Secrets {
+			switch cloned.Source {
+			case "geth-nodekey", "geth-account-key":
+				assert.Equal(t, SecretRegenerated, cloned.Action)
+				assert.Empty(t, cloned.Secret)
+			default:
+				assert.Equal(t, SecretDuplicated, cloned.Action)
+				assert.Equal(t, "geth-copy-"+cloned.Source, cloned.Secret)
+			}
+		}
+		assert.ElementsMatch(t, []string{"geth-copy-geth-jwt", "geth-copy-geth-account-password", "geth-copy"}, recording.Created)
+		assert.Equal(t, "geth-copy", recording.Created[len(recording.Created)-1])
+
+		duplicated := &corev1.Secret{}
+		assert.NoError(t, recording.Get(ctx, types.NamespacedName{Namespace: "default", Name: "geth-copy-geth-jwt"}, duplicated))
+		assert.Equal(t, []byte("geth-jwt-value"), duplicated.Data["key"])
+		assert.True(t, *duplicated.Immutable)
+
+		copied := getNode(t, recording, "geth-copy")
+		assert.Equal(t, "", copied.Spec.NodePrivateKeySecretName)
+		assert.Equal(t, "geth-copy-geth-jwt", copied.Spec.JWTSecretName)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		recording := newClient(t)
+		result, err := NewCloneService().Clone(ctx, kind, "geth", CloneDto{
+			Name:            "geth-copy",
+			Namespace:       "default",
+			SecretOverrides: map[string]string{"geth-nodekey": "other-nodekey"},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, result.Secrets, ClonedSecretDto{Field: "nodePrivateKeySecretName", Source: "geth-nodekey", Secret: "other-nodekey", Action: SecretReplaced})
+		assert.Equal(t, "other-nodekey", getNode(t, recording, "geth-copy").Spec.NodePrivateKeySecretName)
+	})
+
+	t.Run("override with a missing secret", func(t *testing.T) {
+		recording := newClient(t)
+		_, err := NewCloneService().Clone(ctx, kind, "geth", CloneDto{
+			Name:            "geth-copy",
+			Namespace:       "default",
+			SecretOverrides: map[string]string{"geth-nodekey": "missing-nodekey"},
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+		assert.Empty(t, recording.Created)
+	})
+
+	t.Run("duplicated secrets are deleted if the copy can't be created", func(t *testing.T) {
+		recording := newClient(t)
+		recording.FailOn, recording.FailWith = "geth-copy", apiErrors.NewServiceUnavailable("etcd is unavailable")
+
+		_, err := NewCloneService().Clone(ctx, kind, "geth", CloneDto{Name: "geth-copy", Namespace: "default", Secrets: SecretsDuplicate})
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, err.StatusCode())
+		assert.ElementsMatch(t, []string{"geth-copy-geth-jwt", "geth-copy-geth-account-password"}, recording.Created)
+		assert.ElementsMatch(t, recording.Created, recording.Deleted)
+
+		secrets := &corev1.SecretList{}
+		assert.NoError(t, recording.List(ctx, secrets))
+		assert.Len(t, secrets.Items, 5)
+	})
+
+	t.Run("duplicated secrets are deleted if a duplicate can't be created", func(t *testing.T) {
+		recording := newClient(t)
+		recording.FailOn, recording.FailWith = "geth-copy-geth-jwt", apiErrors.NewServiceUnavailable("etcd is unavailable")
+
+		_, err := NewCloneService().Clone(ctx, kind, "geth", CloneDto{Name: "geth-copy", Namespace: "default", Secrets: SecretsDuplicate})
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, err.StatusCode())
+		assert.ElementsMatch(t, recording.Created, recording.Deleted)
+		assert.NotContains(t, recording.Created, "geth-copy")
+	})
+
+	t.Run("source doesn't exist", func(t *testing.T) {
+		newClient(t)
+		_, err := NewCloneService().Clone(ctx, kind, "besu", CloneDto{Name: "besu-copy", Namespace: "default"})
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode())
+	})
+}
@@ -0,0 +1,14 @@
+package name
+
+// NameDto reports whether a name is available in a namespace and the resources using it
+type NameDto struct {
+	Name      string      `json:"name"`
+	Available bool        `json:"available"`
+	UsedBy    []UsedByDto `json:"usedBy"`
+}
+
+// UsedByDto is a resource using a name
+type UsedByDto struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+}
@@ -0,0 +1,72 @@
+// Package name internal is the domain layer for checking resource names
+// a name is available if no kotal resource nor any of the resources generated for them use it
+package name
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type nameService struct{}
+
+type IService interface {
+	Get(ctx context.Context, namespacedName types.NamespacedName) (NameDto, restErrors.IRestErr)
+}
+
+var (
+	k8sClient = k8s.NewClientService()
+)
+
+func NewNameService() IService {
+	return nameService{}
+}
+
+// Get looks the name up across every kotal kind the cluster serves and the kinds generated for them, concurrently
+func (service nameService) Get(ctx context.Context, namespacedName types.NamespacedName) (NameDto, restErrors.IRestErr) {
+	kinds := make([]k8s.Kind, 0, len(k8s.KotalKinds)+len(k8s.GeneratedKinds))
+	for _, kind := range k8s.KotalKinds {
+		if kind.IsServed() {
+			kinds = append(kinds, kind)
+		}
+	}
+	kinds = append(kinds, k8s.GeneratedKinds...)
+
+	found := make([]bool, len(kinds))
+	errs := make([]error, len(kinds))
+	var wg sync.WaitGroup
+	for i, kind := range kinds {
+		wg.Add(1)
+		go func(i int, kind k8s.Kind) {
+			defer wg.Done()
+			err := k8sClient.Get(ctx, namespacedName, kind.NewObject())
+			switch {
+			case err == nil:
+				found[i] = true
+			case !apiErrors.IsNotFound(err):
+				errs[i] = err
+			}
+		}(i, kind)
+	}
+	wg.Wait()
+
+	dto := NameDto{Name: namespacedName.Name, UsedBy: []UsedByDto{}}
+	for i, kind := range kinds {
+		if errs[i] != nil {
+			logger.ErrorContext(ctx, service.Get, errs[i])
+			return NameDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't check name %s", namespacedName.Name))
+		}
+		if found[i] {
+			dto.UsedBy = append(dto.UsedBy, UsedByDto{APIVersion: kind.GroupVersion().String(), Kind: kind.Kind})
+		}
+	}
+	dto.Available = len(dto.UsedBy) == 0
+
+	return dto, nil
+}
@@ -0,0 +1,103 @@
+package name
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/kotalco/community-api/pkg/k8s"
+	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// recordingClient is a fake client recording the kinds it gets
+type recordingClient struct {
+	client.Client
+	scheme *runtime.Scheme
+	lock   sync.Mutex
+	kinds  []schema.GroupVersionKind
+}
+
+func (c *recordingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	c.kinds = append(c.kinds, gvk)
+	c.lock.Unlock()
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func newClient(t *testing.T, objects ...client.Object) *recordingClient {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, k8s.AddToScheme(scheme))
+	recording := &recordingClient{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(), scheme: scheme}
+	k8sClient = recording
+	return recording
+}
+
+func meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: "default"}
+}
+
+func TestGet(t *testing.T) {
+	name := types.NamespacedName{Namespace: "default", Name: "my-node"}
+
+	t.Run("checks every kind", func(t *testing.T) {
+		recording := newClient(t)
+		dto, err := NewNameService().Get(context.Background(), name)
+		assert.Nil(t, err)
+		assert.True(t, dto.Available)
+		assert.Empty(t, dto.UsedBy)
+
+		expected := make([]schema.GroupVersionKind, 0)
+		for _, kind := range k8s.KotalKinds {
+			expected = append(expected, kind.GroupVersionKind)
+		}
+		expected = append(expected,
+			appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+			corev1.SchemeGroupVersion.WithKind("Service"),
+			corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+			corev1.SchemeGroupVersion.WithKind("Secret"),
+		)
+		assert.Len(t, expected, 16)
+		assert.ElementsMatch(t, expected, recording.kinds)
+	})
+
+	t.Run("used by a kotal resource", func(t *testing.T) {
+		newClient(t, &ipfsv1alpha1.Peer{ObjectMeta: meta("my-node")})
+		dto, err := NewNameService().Get(context.Background(), name)
+		assert.Nil(t, err)
+		assert.False(t, dto.Available)
+		assert.Equal(t, []UsedByDto{{APIVersion: "ipfs.kotal.io/v1alpha1", Kind: "Peer"}}, dto.UsedBy)
+	})
+
+	t.Run("used by generated resources", func(t *testing.T) {
+		newClient(t,
+			&appsv1.StatefulSet{ObjectMeta: meta("my-node")},
+			&corev1.Service{ObjectMeta: meta("my-node")},
+			&corev1.ConfigMap{ObjectMeta: meta("my-node")},
+			&corev1.Secret{ObjectMeta: meta("my-node")},
+			&corev1.Secret{ObjectMeta: meta("other-node")},
+		)
+		dto, err := NewNameService().Get(context.Background(), name)
+		assert.Nil(t, err)
+		assert.False(t, dto.Available)
+		assert.ElementsMatch(t, []UsedByDto{
+			{APIVersion: "apps/v1", Kind: "StatefulSet"},
+			{APIVersion: "v1", Kind: "Service"},
+			{APIVersion: "v1", Kind: "ConfigMap"},
+			{APIVersion: "v1", Kind: "Secret"},
+		}, dto.UsedBy)
+	})
+}
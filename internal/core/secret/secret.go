@@ -17,7 +17,7 @@ type SecretDto struct {
 type SecretsDto []SecretDto
 
 func (dto SecretDto) FromCoreSecret(s corev1.Secret) SecretDto {
-	dto.Name = s.Name
+	dto.FromObjectMeta(s.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: s.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Type = s.Labels["kotal.io/key-type"]
 
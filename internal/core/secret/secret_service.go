@@ -10,7 +10,6 @@ import (
 	"github.com/kotalco/community-api/pkg/logger"
 	corev1 "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -18,11 +17,11 @@ import (
 type secretService struct{}
 
 type IService interface {
-	Get(name types.NamespacedName) (corev1.Secret, restErrors.IRestErr)
-	Create(SecretDto) (corev1.Secret, restErrors.IRestErr)
-	List(namespace string) (corev1.SecretList, restErrors.IRestErr)
-	Delete(*corev1.Secret) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(ctx context.Context, name types.NamespacedName) (corev1.Secret, restErrors.IRestErr)
+	Create(context.Context, SecretDto) (corev1.Secret, restErrors.IRestErr)
+	List(ctx context.Context, namespace string) (corev1.SecretList, restErrors.IRestErr)
+	Delete(context.Context, *corev1.Secret) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -34,13 +33,13 @@ func NewSecretService() IService {
 }
 
 // Get returns a single secret  by name
-func (service secretService) Get(namespacedName types.NamespacedName) (secret corev1.Secret, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &secret); err != nil {
+func (service secretService) Get(ctx context.Context, namespacedName types.NamespacedName) (secret corev1.Secret, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &secret); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("secret by name %s doesn't exist", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get secret by name %s", namespacedName.Name))
 		return
 	}
@@ -48,26 +47,24 @@ func (service secretService) Get(namespacedName types.NamespacedName) (secret co
 }
 
 // Create creates a secret from the given spec
-func (service secretService) Create(dto SecretDto) (secret corev1.Secret, restErr restErrors.IRestErr) {
+func (service secretService) Create(ctx context.Context, dto SecretDto) (secret corev1.Secret, restErr restErrors.IRestErr) {
 
 	t := true
-	secret.ObjectMeta = metav1.ObjectMeta{
-		Name:      dto.Name,
-		Namespace: dto.Namespace,
-		Labels: map[string]string{
-			"kotal.io/key-type":            dto.Type,
-			"app.kubernetes.io/created-by": "kotal-api",
-		},
+	secret.ObjectMeta = dto.ObjectMetaFromMetadataDto()
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
 	}
+	secret.Labels["kotal.io/key-type"] = dto.Type
+	secret.Labels["app.kubernetes.io/created-by"] = "kotal-api"
 	secret.StringData = dto.Data
 	secret.Immutable = &t
 
-	if err := k8sClient.Create(context.Background(), &secret); err != nil {
+	if err := k8sClient.Create(ctx, &secret); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("secret by name %s already exist", dto.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("error creating secret")
 		return
 	}
@@ -75,9 +72,9 @@ func (service secretService) Create(dto SecretDto) (secret corev1.Secret, restEr
 }
 
 // List returns all secrets
-func (service secretService) List(namespace string) (list corev1.SecretList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace), client.HasLabels{"app.kubernetes.io/created-by"}); err != nil {
-		go logger.Error(service.List, err)
+func (service secretService) List(ctx context.Context, namespace string) (list corev1.SecretList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace), client.HasLabels{"app.kubernetes.io/created-by"}); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all secrets")
 		return
 	}
@@ -86,9 +83,9 @@ func (service secretService) List(namespace string) (list corev1.SecretList, res
 }
 
 // Delete a single secret node by name
-func (service secretService) Delete(secret *corev1.Secret) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), secret); err != nil {
-		go logger.Error(service.Delete, err)
+func (service secretService) Delete(ctx context.Context, secret *corev1.Secret) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, secret); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete secret by name %s", secret.Name))
 		return
 	}
@@ -96,10 +93,10 @@ func (service secretService) Delete(secret *corev1.Secret) (restErr restErrors.I
 }
 
 // Count counts secrets
-func (service secretService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service secretService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	secrets := &corev1.SecretList{}
-	if err := k8sClient.List(context.Background(), secrets, client.InNamespace(namespace), client.HasLabels{"kotal.io/key-type"}); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, secrets, client.InNamespace(namespace), client.HasLabels{"kotal.io/key-type"}); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to get all secrets")
 		return
 	}
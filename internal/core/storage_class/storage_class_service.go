@@ -16,12 +16,12 @@ import (
 type storageClassService struct{}
 
 type IService interface {
-	Get(name string) (storagev1.StorageClass, restErrors.IRestErr)
-	Create(dto StorageClassDto) (storagev1.StorageClass, restErrors.IRestErr)
-	Update(StorageClassDto, *storagev1.StorageClass) restErrors.IRestErr
-	List() (storagev1.StorageClassList, restErrors.IRestErr)
-	Delete(*storagev1.StorageClass) restErrors.IRestErr
-	Count() (int, restErrors.IRestErr)
+	Get(ctx context.Context, name string) (storagev1.StorageClass, restErrors.IRestErr)
+	Create(ctx context.Context, dto StorageClassDto) (storagev1.StorageClass, restErrors.IRestErr)
+	Update(context.Context, StorageClassDto, *storagev1.StorageClass) restErrors.IRestErr
+	List(context.Context) (storagev1.StorageClassList, restErrors.IRestErr)
+	Delete(context.Context, *storagev1.StorageClass) restErrors.IRestErr
+	Count(context.Context) (int, restErrors.IRestErr)
 }
 
 var (
@@ -33,14 +33,14 @@ func NewStorageClassService() IService {
 }
 
 // Get returns a single storage class  by name
-func (service storageClassService) Get(name string) (storageClass storagev1.StorageClass, restErr restErrors.IRestErr) {
+func (service storageClassService) Get(ctx context.Context, name string) (storageClass storagev1.StorageClass, restErr restErrors.IRestErr) {
 	key := types.NamespacedName{Name: name}
-	if err := k8sClient.Get(context.Background(), key, &storageClass); err != nil {
+	if err := k8sClient.Get(ctx, key, &storageClass); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("storage class by name %s doens't exit", key.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get storage class by name %s", key.Name))
 		return
 	}
@@ -50,20 +50,20 @@ func (service storageClassService) Get(name string) (storageClass storagev1.Stor
 
 // Create creates a storage class from the given spec
 // todo
-func (service storageClassService) Create(dto StorageClassDto) (storageClass storagev1.StorageClass, restErr restErrors.IRestErr) {
+func (service storageClassService) Create(ctx context.Context, dto StorageClassDto) (storageClass storagev1.StorageClass, restErr restErrors.IRestErr) {
 	return
 }
 
 // Update creates a storage class from the given spec
 // todo
-func (service storageClassService) Update(dto StorageClassDto, storageClass *storagev1.StorageClass) (restErr restErrors.IRestErr) {
+func (service storageClassService) Update(ctx context.Context, dto StorageClassDto, storageClass *storagev1.StorageClass) (restErr restErrors.IRestErr) {
 	return
 }
 
 // List returns all storage classes
-func (service storageClassService) List() (list storagev1.StorageClassList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list); err != nil {
-		go logger.Error(service.List, err)
+func (service storageClassService) List(ctx context.Context) (list storagev1.StorageClassList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get storage class list")
 		return
 	}
@@ -72,12 +72,12 @@ func (service storageClassService) List() (list storagev1.StorageClassList, rest
 
 // Delete a single storage node by name
 // todo
-func (service storageClassService) Delete(storageClass *storagev1.StorageClass) (restErr restErrors.IRestErr) {
+func (service storageClassService) Delete(ctx context.Context, storageClass *storagev1.StorageClass) (restErr restErrors.IRestErr) {
 	return
 }
 
 // Count a list of storage classes
 // todo
-func (service storageClassService) Count() (count int, restErr restErrors.IRestErr) {
+func (service storageClassService) Count(ctx context.Context) (count int, restErr restErrors.IRestErr) {
 	return
 }
@@ -0,0 +1,35 @@
+package diagnostic
+
+import "github.com/kotalco/community-api/pkg/k8s"
+
+// container states
+const (
+	StateRunning    = "running"
+	StateWaiting    = "waiting"
+	StateTerminated = "terminated"
+)
+
+// DiagnosticsDto explains why a resource crashed from the last termination and logs of the containers of its pod
+type DiagnosticsDto struct {
+	Name       string                    `json:"name"`
+	Pod        string                    `json:"pod,omitempty"`
+	Status     k8s.StatusDto             `json:"status"`
+	Containers []ContainerDiagnosticsDto `json:"containers"`
+}
+
+// ContainerDiagnosticsDto is the state of a container, how it last terminated with its logs at the time and the hints matching them
+// Logs are the previous logs of the container if it was restarted since it terminated
+type ContainerDiagnosticsDto struct {
+	Name        string              `json:"name"`
+	Init        bool                `json:"init"`
+	Image       string              `json:"image"`
+	Ready       bool                `json:"ready"`
+	Restarts    int32               `json:"restarts"`
+	State       string              `json:"state"`
+	Reason      string              `json:"reason,omitempty"`
+	Termination *k8s.TerminationDto `json:"termination,omitempty"`
+	Previous    bool                `json:"previous"`
+	Logs        string              `json:"logs,omitempty"`
+	LogsError   string              `json:"logsError,omitempty"`
+	Hints       []k8s.HintDto       `json:"hints"`
+}
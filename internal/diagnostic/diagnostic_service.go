@@ -0,0 +1,113 @@
+// Package diagnostic internal is the domain layer for crash diagnostics
+// explains why a resource crashed from the termination state and the previous logs of its containers
+package diagnostic
+
+import (
+	"context"
+	"fmt"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// logLines is the number of lines of the logs of a terminated container
+	logLines = int64(100)
+	// logBytes caps the logs of a terminated container
+	logBytes = int64(64 * 1024)
+)
+
+type diagnosticService struct{}
+
+type IService interface {
+	Get(ctx context.Context, name types.NamespacedName) (DiagnosticsDto, restErrors.IRestErr)
+}
+
+var (
+	k8sClient          = k8s.NewClientService()
+	statefulSetService = statefulset.NewService()
+)
+
+func NewDiagnosticService() IService {
+	return diagnosticService{}
+}
+
+// Get returns the diagnostics of the pod of a resource by name
+// resources without a pod have no containers to diagnose, their status tells why
+func (service diagnosticService) Get(ctx context.Context, name types.NamespacedName) (DiagnosticsDto, restErrors.IRestErr) {
+	status, restErr := statefulSetService.Status(ctx, name)
+	if restErr != nil {
+		return DiagnosticsDto{}, restErr
+	}
+	dto := DiagnosticsDto{Name: name.Name, Status: status, Containers: make([]ContainerDiagnosticsDto, 0)}
+
+	pod := &corev1.Pod{}
+	podName := types.NamespacedName{Namespace: name.Namespace, Name: fmt.Sprintf("%s-0", name.Name)}
+	if err := k8sClient.Get(ctx, podName, pod); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return dto, nil
+		}
+		logger.ErrorContext(ctx, service.Get, err)
+		return DiagnosticsDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't get pod of %s", name.Name))
+	}
+	dto.Pod = pod.Name
+
+	for _, container := range pod.Status.InitContainerStatuses {
+		dto.Containers = append(dto.Containers, service.container(ctx, pod, container, true))
+	}
+	for _, container := range pod.Status.ContainerStatuses {
+		dto.Containers = append(dto.Containers, service.container(ctx, pod, container, false))
+	}
+	return dto, nil
+}
+
+// container returns the diagnostics of a container, the logs of crashed containers are read at the time they terminated
+// failures to read the logs are reported in the diagnostics, the kubelet may have garbage collected them
+func (service diagnosticService) container(ctx context.Context, pod *corev1.Pod, status corev1.ContainerStatus, init bool) ContainerDiagnosticsDto {
+	dto := ContainerDiagnosticsDto{
+		Name:     status.Name,
+		Init:     init,
+		Image:    status.Image,
+		Ready:    status.Ready,
+		Restarts: status.RestartCount,
+		State:    StateWaiting,
+		Hints:    make([]k8s.HintDto, 0),
+	}
+	switch {
+	case status.State.Running != nil:
+		dto.State = StateRunning
+	case status.State.Terminated != nil:
+		dto.State, dto.Reason = StateTerminated, status.State.Terminated.Reason
+	case status.State.Waiting != nil:
+		dto.Reason = status.State.Waiting.Reason
+	}
+
+	// waiting reasons like ImagePullBackOff have hints of their own, crash loops are explained by the last termination
+	if dto.State == StateWaiting {
+		dto.Hints = k8s.MatchHints(dto.Reason, 0, "")
+	}
+
+	// completed init containers didn't crash, their logs aren't read
+	dto.Termination, dto.Previous = k8s.LastTermination(status)
+	if dto.Termination == nil || dto.Termination.ExitCode == 0 {
+		return dto
+	}
+
+	lines, bytes := logLines, logBytes
+	opts := &corev1.PodLogOptions{Container: status.Name, Previous: dto.Previous, TailLines: &lines, LimitBytes: &bytes}
+	logs, err := k8s.Clientset().CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).DoRaw(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, service.container, err)
+		dto.LogsError = fmt.Sprintf("can't get logs of container %s", status.Name)
+	} else {
+		dto.Logs = string(logs)
+	}
+
+	dto.Hints = append(dto.Hints, k8s.MatchHints(dto.Termination.Reason, dto.Termination.ExitCode, dto.Logs)...)
+	return dto
+}
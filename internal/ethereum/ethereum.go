@@ -17,6 +17,7 @@ type ImportedAccount struct {
 // Node is Ethereum node
 type EthereumDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network                  string                                `json:"network"`
 	Client                   string                                `json:"client"`
@@ -48,7 +49,7 @@ type EthereumDto struct {
 type EthereumListDto []EthereumDto
 
 func (dto EthereumDto) FromEthereumNode(node ethereumv1alpha1.Node) EthereumDto {
-	dto.Name = node.Name
+	dto.FromObjectMeta(node.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: node.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Network = node.Spec.Network
 	dto.Client = string(node.Spec.Client)
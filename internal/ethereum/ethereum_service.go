@@ -20,12 +20,12 @@ import (
 type ethereumService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (ethereumv1alpha1.Node, restErrors.IRestErr)
-	Create(EthereumDto) (ethereumv1alpha1.Node, restErrors.IRestErr)
-	Update(EthereumDto, *ethereumv1alpha1.Node) restErrors.IRestErr
-	List(namespace string) (ethereumv1alpha1.NodeList, restErrors.IRestErr)
-	Delete(*ethereumv1alpha1.Node) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (ethereumv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, EthereumDto) (ethereumv1alpha1.Node, restErrors.IRestErr)
+	Update(context.Context, EthereumDto, *ethereumv1alpha1.Node) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (ethereumv1alpha1.NodeList, restErrors.IRestErr)
+	Delete(context.Context, *ethereumv1alpha1.Node) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -37,13 +37,13 @@ func NewEthereumService() IService {
 }
 
 // Get returns a single ethereum node by name
-func (service ethereumService) Get(namespacedName types.NamespacedName) (node ethereumv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service ethereumService) Get(ctx context.Context, namespacedName types.NamespacedName) (node ethereumv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exist", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
@@ -52,7 +52,7 @@ func (service ethereumService) Get(namespacedName types.NamespacedName) (node et
 }
 
 // Create creates ethereum node from the given spec
-func (service ethereumService) Create(dto EthereumDto) (node ethereumv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service ethereumService) Create(ctx context.Context, dto EthereumDto) (node ethereumv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = ethereumv1alpha1.NodeSpec{
 		Network: dto.Network,
@@ -67,13 +67,13 @@ func (service ethereumService) Create(dto EthereumDto) (node ethereumv1alpha1.No
 		node.Default()
 	}
 
-	err := k8sClient.Create(context.Background(), &node)
+	err := k8sClient.Create(ctx, &node)
 	if err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %s already exist", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -82,7 +82,10 @@ func (service ethereumService) Create(dto EthereumDto) (node ethereumv1alpha1.No
 }
 
 // Update updates a single ethereum node by name from spec
-func (service ethereumService) Update(dto EthereumDto, node *ethereumv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service ethereumService) Update(ctx context.Context, dto EthereumDto, node *ethereumv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
 
 	if dto.Logging != "" {
 		node.Spec.Logging = sharedAPI.VerbosityLevel(dto.Logging)
@@ -215,25 +218,25 @@ func (service ethereumService) Update(dto EthereumDto, node *ethereumv1alpha1.No
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -243,10 +246,10 @@ func (service ethereumService) Update(dto EthereumDto, node *ethereumv1alpha1.No
 }
 
 // List returns all ethereum nodes
-func (service ethereumService) List(namespace string) (list ethereumv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace))
+func (service ethereumService) List(ctx context.Context, namespace string) (list ethereumv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	err := k8sClient.List(ctx, &list, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.List, err)
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -254,8 +257,8 @@ func (service ethereumService) List(namespace string) (list ethereumv1alpha1.Nod
 }
 
 // Count returns the length of ethereum nodes
-func (service ethereumService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
-	nodes, err := service.List(namespace)
+func (service ethereumService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
+	nodes, err := service.List(ctx, namespace)
 	if err != nil {
 		restErr = restErrors.NewInternalServerError("failed to count all nodes")
 		return
@@ -265,11 +268,11 @@ func (service ethereumService) Count(namespace string) (count int, restErr restE
 }
 
 // Delete a single ethereum node by name
-func (service ethereumService) Delete(node *ethereumv1alpha1.Node) (restErr restErrors.IRestErr) {
-	err := k8sClient.Delete(context.Background(), node)
+func (service ethereumService) Delete(ctx context.Context, node *ethereumv1alpha1.Node) (restErr restErrors.IRestErr) {
+	err := k8sClient.Delete(ctx, node)
 
 	if err != nil {
-		go logger.Error(service.Delete, err)
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 		return
 	}
@@ -10,6 +10,7 @@ import (
 
 type BeaconNodeDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network                 string  `json:"network"`
 	Client                  string  `json:"client"`
@@ -28,7 +29,7 @@ type BeaconNodeDto struct {
 type BeaconNodeListDto []BeaconNodeDto
 
 func (dto BeaconNodeDto) FromEthereum2BeaconNode(node ethereum2v1alpha1.BeaconNode) BeaconNodeDto {
-	dto.Name = node.Name
+	dto.FromObjectMeta(node.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: node.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Network = node.Spec.Network
 	dto.Client = string(node.Spec.Client)
@@ -20,12 +20,12 @@ import (
 type beaconNodeService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (ethereum2v1alpha1.BeaconNode, restErrors.IRestErr)
-	Create(dto BeaconNodeDto) (ethereum2v1alpha1.BeaconNode, restErrors.IRestErr)
-	Update(BeaconNodeDto, *ethereum2v1alpha1.BeaconNode) restErrors.IRestErr
-	List(namespace string) (ethereum2v1alpha1.BeaconNodeList, restErrors.IRestErr)
-	Delete(*ethereum2v1alpha1.BeaconNode) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (ethereum2v1alpha1.BeaconNode, restErrors.IRestErr)
+	Create(ctx context.Context, dto BeaconNodeDto) (ethereum2v1alpha1.BeaconNode, restErrors.IRestErr)
+	Update(context.Context, BeaconNodeDto, *ethereum2v1alpha1.BeaconNode) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (ethereum2v1alpha1.BeaconNodeList, restErrors.IRestErr)
+	Delete(context.Context, *ethereum2v1alpha1.BeaconNode) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -37,13 +37,13 @@ func NewBeaconNodeService() IService {
 }
 
 // Get gets a single ethereum 2.0 beacon node by name
-func (service beaconNodeService) Get(namespacedNamed types.NamespacedName) (node ethereum2v1alpha1.BeaconNode, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedNamed, &node); err != nil {
+func (service beaconNodeService) Get(ctx context.Context, namespacedNamed types.NamespacedName) (node ethereum2v1alpha1.BeaconNode, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedNamed, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("beacon node by name %s doesn't exist", namespacedNamed.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get beacon node by name %s", namespacedNamed.Name))
 		return
 	}
@@ -52,7 +52,7 @@ func (service beaconNodeService) Get(namespacedNamed types.NamespacedName) (node
 }
 
 // Create creates ethereum 2.0 beacon node from spec
-func (service beaconNodeService) Create(dto BeaconNodeDto) (node ethereum2v1alpha1.BeaconNode, restErr restErrors.IRestErr) {
+func (service beaconNodeService) Create(ctx context.Context, dto BeaconNodeDto) (node ethereum2v1alpha1.BeaconNode, restErr restErrors.IRestErr) {
 	client := ethereum2v1alpha1.Ethereum2Client(dto.Client)
 
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
@@ -80,12 +80,12 @@ func (service beaconNodeService) Create(dto BeaconNodeDto) (node ethereum2v1alph
 		node.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("beacon node by name %s already exist", dto.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create beacon node")
 		return
 	}
@@ -94,7 +94,11 @@ func (service beaconNodeService) Create(dto BeaconNodeDto) (node ethereum2v1alph
 }
 
 // Update updates ethereum 2.0 beacon node by name from spec
-func (service beaconNodeService) Update(dto BeaconNodeDto, node *ethereum2v1alpha1.BeaconNode) (restErr restErrors.IRestErr) {
+func (service beaconNodeService) Update(ctx context.Context, dto BeaconNodeDto, node *ethereum2v1alpha1.BeaconNode) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.REST != nil {
 		rest := *dto.REST
 		if rest {
@@ -164,25 +168,25 @@ func (service beaconNodeService) Update(dto BeaconNodeDto, node *ethereum2v1alph
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -192,9 +196,9 @@ func (service beaconNodeService) Update(dto BeaconNodeDto, node *ethereum2v1alph
 }
 
 // List returns all ethereum 2.0 beacon nodes
-func (service beaconNodeService) List(namespace string) (list ethereum2v1alpha1.BeaconNodeList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service beaconNodeService) List(ctx context.Context, namespace string) (list ethereum2v1alpha1.BeaconNodeList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all beacon nodes")
 		return
 	}
@@ -203,8 +207,8 @@ func (service beaconNodeService) List(namespace string) (list ethereum2v1alpha1.
 }
 
 // Count returns total number of beacon nodes
-func (service beaconNodeService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
-	nodes, err := service.List(namespace)
+func (service beaconNodeService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
+	nodes, err := service.List(ctx, namespace)
 	if err != nil {
 		restErr = restErrors.NewInternalServerError("failed to count all nodes")
 		return
@@ -214,11 +218,11 @@ func (service beaconNodeService) Count(namespace string) (count int, restErr res
 }
 
 // Delete deletes ethereum 2.0 beacon node by name
-func (service beaconNodeService) Delete(node *ethereum2v1alpha1.BeaconNode) (restErr restErrors.IRestErr) {
-	err := k8sClient.Delete(context.Background(), node)
+func (service beaconNodeService) Delete(ctx context.Context, node *ethereum2v1alpha1.BeaconNode) (restErr restErrors.IRestErr) {
+	err := k8sClient.Delete(ctx, node)
 
 	if err != nil {
-		go logger.Error(service.Delete, err)
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 		return
 	}
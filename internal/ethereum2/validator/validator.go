@@ -10,6 +10,7 @@ import (
 
 type ValidatorDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network                  string                       `json:"network"`
 	Client                   string                       `json:"client"`
@@ -24,7 +25,7 @@ type ValidatorDto struct {
 type ValidatorListDto []ValidatorDto
 
 func (dto ValidatorDto) FromEthereum2Validator(validator ethereum2v1alpha1.Validator) ValidatorDto {
-	dto.Name = validator.Name
+	dto.FromObjectMeta(validator.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: validator.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Network = validator.Spec.Network
 	dto.Client = string(validator.Spec.Client)
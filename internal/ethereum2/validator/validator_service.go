@@ -18,12 +18,12 @@ import (
 type validatorService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (ethereum2v1alpha1.Validator, restErrors.IRestErr)
-	Create(dto ValidatorDto) (ethereum2v1alpha1.Validator, restErrors.IRestErr)
-	Update(ValidatorDto, *ethereum2v1alpha1.Validator) restErrors.IRestErr
-	List(namespace string) (ethereum2v1alpha1.ValidatorList, restErrors.IRestErr)
-	Delete(*ethereum2v1alpha1.Validator) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (ethereum2v1alpha1.Validator, restErrors.IRestErr)
+	Create(ctx context.Context, dto ValidatorDto) (ethereum2v1alpha1.Validator, restErrors.IRestErr)
+	Update(context.Context, ValidatorDto, *ethereum2v1alpha1.Validator) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (ethereum2v1alpha1.ValidatorList, restErrors.IRestErr)
+	Delete(context.Context, *ethereum2v1alpha1.Validator) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -35,13 +35,13 @@ func NewValidatorService() IService {
 }
 
 // Get gets a single ethereum 2.0 beacon node by name
-func (service validatorService) Get(namespacedName types.NamespacedName) (validator ethereum2v1alpha1.Validator, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &validator); err != nil {
+func (service validatorService) Get(ctx context.Context, namespacedName types.NamespacedName) (validator ethereum2v1alpha1.Validator, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &validator); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("validator by name %s doesn't exit", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get a validator by name %s", namespacedName.Name))
 		return
 	}
@@ -50,7 +50,7 @@ func (service validatorService) Get(namespacedName types.NamespacedName) (valida
 }
 
 // Create creates ethereum 2.0 beacon node from spec
-func (service validatorService) Create(dto ValidatorDto) (validator ethereum2v1alpha1.Validator, restErr restErrors.IRestErr) {
+func (service validatorService) Create(ctx context.Context, dto ValidatorDto) (validator ethereum2v1alpha1.Validator, restErr restErrors.IRestErr) {
 	validator.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	validator.Spec = ethereum2v1alpha1.ValidatorSpec{
 		Network:   dto.Network,
@@ -78,12 +78,12 @@ func (service validatorService) Create(dto ValidatorDto) (validator ethereum2v1a
 		validator.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &validator); err != nil {
+	if err := k8sClient.Create(ctx, &validator); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("validator by name %s already exits", validator.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create validator")
 		return
 	}
@@ -92,7 +92,11 @@ func (service validatorService) Create(dto ValidatorDto) (validator ethereum2v1a
 }
 
 // Update updates ethereum 2.0 beacon node by name from spec
-func (service validatorService) Update(dto ValidatorDto, validator *ethereum2v1alpha1.Validator) (restErr restErrors.IRestErr) {
+func (service validatorService) Update(ctx context.Context, dto ValidatorDto, validator *ethereum2v1alpha1.Validator) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&validator.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.WalletPasswordSecretName != "" {
 		validator.Spec.WalletPasswordSecret = dto.WalletPasswordSecretName
 	}
@@ -145,25 +149,25 @@ func (service validatorService) Update(dto ValidatorDto, validator *ethereum2v1a
 			Namespace: validator.Namespace,
 			Name:      fmt.Sprintf("%s-0", validator.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), validator); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, validator); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", validator.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", validator.Name))
 			return
 		}
@@ -172,9 +176,9 @@ func (service validatorService) Update(dto ValidatorDto, validator *ethereum2v1a
 }
 
 // List returns all ethereum 2.0 beacon nodes
-func (service validatorService) List(namespace string) (list ethereum2v1alpha1.ValidatorList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service validatorService) List(ctx context.Context, namespace string) (list ethereum2v1alpha1.ValidatorList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all validators")
 		return
 	}
@@ -183,11 +187,11 @@ func (service validatorService) List(namespace string) (list ethereum2v1alpha1.V
 }
 
 // Count returns total number of beacon nodes
-func (service validatorService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service validatorService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	validators := &ethereum2v1alpha1.ValidatorList{}
 
-	if err := k8sClient.List(context.Background(), validators, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, validators, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("error counting validators")
 		return
 	}
@@ -196,9 +200,9 @@ func (service validatorService) Count(namespace string) (count int, restErr rest
 }
 
 // Delete deletes ethereum 2.0 beacon node by name
-func (service validatorService) Delete(validator *ethereum2v1alpha1.Validator) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), validator); err != nil {
-		go logger.Error(service.Delete, err)
+func (service validatorService) Delete(ctx context.Context, validator *ethereum2v1alpha1.Validator) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, validator); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewBadRequestError(fmt.Sprintf("can't delete validator by name %s", validator.Name))
 		return
 	}
@@ -0,0 +1,103 @@
+// Package event internal is the domain layer for kubernetes events
+// returns the events of a resource and of the objects the kotal operator created for it
+package event
+
+import (
+	"context"
+	"fmt"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type eventService struct{}
+
+type IService interface {
+	List(ctx context.Context, kind k8s.Kind, name types.NamespacedName) ([]k8s.EventDto, restErrors.IRestErr)
+	Stream(ctx context.Context, kind k8s.Kind, name types.NamespacedName, send func(k8s.EventDto) error) restErrors.IRestErr
+}
+
+var (
+	k8sClient = k8s.NewClientService()
+)
+
+func NewEventService() IService {
+	return eventService{}
+}
+
+// List returns the events of a resource of kind by name, its statefulset, pods, volume and service
+// events are de-duplicated and sorted by the time they were last seen
+func (service eventService) List(ctx context.Context, kind k8s.Kind, name types.NamespacedName) ([]k8s.EventDto, restErrors.IRestErr) {
+	events, _, restErr := service.list(ctx, kind, name)
+	if restErr != nil {
+		return nil, restErr
+	}
+	return k8s.NewEventDtos(events), nil
+}
+
+// Stream sends the events of a resource of kind by name until ctx is done, the watch ends or send fails
+// the current events are sent first, then every event recorded or updated after them
+func (service eventService) Stream(ctx context.Context, kind k8s.Kind, name types.NamespacedName, send func(k8s.EventDto) error) restErrors.IRestErr {
+	events, resourceVersion, restErr := service.list(ctx, kind, name)
+	if restErr != nil {
+		return restErr
+	}
+	for _, dto := range k8s.NewEventDtos(events) {
+		if err := send(dto); err != nil {
+			return nil
+		}
+	}
+
+	watcher, err := k8s.Clientset().CoreV1().Events(name.Namespace).Watch(ctx, metav1.ListOptions{ResourceVersion: resourceVersion})
+	if err != nil {
+		logger.ErrorContext(ctx, service.Stream, err)
+		return restErrors.NewInternalServerError(fmt.Sprintf("can't watch events of %s", name.Name))
+	}
+	defer watcher.Stop()
+
+	for result := range watcher.ResultChan() {
+		if result.Type != watch.Added && result.Type != watch.Modified {
+			continue
+		}
+		event, ok := result.Object.(*corev1.Event)
+		if !ok || !k8s.IsResourceEvent(kind, name.Name, event) {
+			continue
+		}
+		if err := send(k8s.NewEventDto(event)); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// list returns the events of the resource and the resource version to watch them from
+func (service eventService) list(ctx context.Context, kind k8s.Kind, name types.NamespacedName) ([]corev1.Event, string, restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, name, kind.NewObject()); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, "", restErrors.NewNotFoundError(fmt.Sprintf("%s by name %s doesn't exist", kind.Kind, name.Name))
+		}
+		logger.ErrorContext(ctx, service.list, err)
+		return nil, "", restErrors.NewInternalServerError(fmt.Sprintf("can't get %s by name %s", kind.Kind, name.Name))
+	}
+
+	list := &corev1.EventList{}
+	if err := k8sClient.List(ctx, list, client.InNamespace(name.Namespace)); err != nil {
+		logger.ErrorContext(ctx, service.list, err)
+		return nil, "", restErrors.NewInternalServerError(fmt.Sprintf("can't list events of %s", name.Name))
+	}
+
+	events := make([]corev1.Event, 0)
+	for i := range list.Items {
+		if k8s.IsResourceEvent(kind, name.Name, &list.Items[i]) {
+			events = append(events, list.Items[i])
+		}
+	}
+	return events, list.ResourceVersion, nil
+}
@@ -11,6 +11,7 @@ import (
 // Node is Filecoin node
 type FilecoinDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network            string  `json:"network"`
 	API                *bool   `json:"api"`
@@ -30,7 +31,7 @@ type FilecoinListDto []FilecoinDto
 // FromFilecoinNode creates node dto from Filecoin node
 func (dto FilecoinDto) FromFilecoinNode(node filecoinv1alpha1.Node) FilecoinDto {
 
-	dto.Name = node.Name
+	dto.FromObjectMeta(node.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: node.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Network = string(node.Spec.Network)
 	dto.API = &node.Spec.API
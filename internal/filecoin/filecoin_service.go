@@ -18,12 +18,12 @@ import (
 type filecoinService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (filecoinv1alpha1.Node, restErrors.IRestErr)
-	Create(FilecoinDto) (filecoinv1alpha1.Node, restErrors.IRestErr)
-	Update(FilecoinDto, *filecoinv1alpha1.Node) restErrors.IRestErr
-	List(namespace string) (filecoinv1alpha1.NodeList, restErrors.IRestErr)
-	Delete(*filecoinv1alpha1.Node) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (filecoinv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, FilecoinDto) (filecoinv1alpha1.Node, restErrors.IRestErr)
+	Update(context.Context, FilecoinDto, *filecoinv1alpha1.Node) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (filecoinv1alpha1.NodeList, restErrors.IRestErr)
+	Delete(context.Context, *filecoinv1alpha1.Node) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -35,13 +35,13 @@ func NewFilecoinService() IService {
 }
 
 // Get gets a single filecoin node by name
-func (service filecoinService) Get(namespacedName types.NamespacedName) (node filecoinv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service filecoinService) Get(ctx context.Context, namespacedName types.NamespacedName) (node filecoinv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exit", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
@@ -50,7 +50,7 @@ func (service filecoinService) Get(namespacedName types.NamespacedName) (node fi
 }
 
 // Create creates filecoin node from spec
-func (service filecoinService) Create(dto FilecoinDto) (node filecoinv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service filecoinService) Create(ctx context.Context, dto FilecoinDto) (node filecoinv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = filecoinv1alpha1.NodeSpec{
 		Network: filecoinv1alpha1.FilecoinNetwork(dto.Network),
@@ -67,12 +67,12 @@ func (service filecoinService) Create(dto FilecoinDto) (node filecoinv1alpha1.No
 		node.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %+v already exits", dto))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -81,7 +81,11 @@ func (service filecoinService) Create(dto FilecoinDto) (node filecoinv1alpha1.No
 }
 
 // Update updates filecoin node by name from spec
-func (service filecoinService) Update(dto FilecoinDto, node *filecoinv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service filecoinService) Update(ctx context.Context, dto FilecoinDto, node *filecoinv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.API != nil {
 		node.Spec.API = *dto.API
 	}
@@ -144,25 +148,25 @@ func (service filecoinService) Update(dto FilecoinDto, node *filecoinv1alpha1.No
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -172,9 +176,9 @@ func (service filecoinService) Update(dto FilecoinDto, node *filecoinv1alpha1.No
 }
 
 // List returns all filecoin nodes
-func (service filecoinService) List(namespace string) (list filecoinv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service filecoinService) List(ctx context.Context, namespace string) (list filecoinv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -182,10 +186,10 @@ func (service filecoinService) List(namespace string) (list filecoinv1alpha1.Nod
 }
 
 // Count returns total number of filecoin nodes
-func (service filecoinService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service filecoinService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &filecoinv1alpha1.NodeList{}
-	if err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, nodes, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to count filecoin nodes")
 		return
 	}
@@ -194,9 +198,9 @@ func (service filecoinService) Count(namespace string) (count int, restErr restE
 }
 
 // Delete deletes ethereum 2.0 filecoin node by name
-func (service filecoinService) Delete(node *filecoinv1alpha1.Node) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), node); err != nil {
-		go logger.Error(service.Delete, err)
+func (service filecoinService) Delete(ctx context.Context, node *filecoinv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delte node by name %s", node.Name))
 		return
 	}
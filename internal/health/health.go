@@ -0,0 +1,20 @@
+package health
+
+const (
+	StatusAvailable   = "available"
+	StatusUnavailable = "unavailable"
+)
+
+// CheckDto is the state of a single dependency of the api server
+type CheckDto struct {
+	Status   string `json:"status"`
+	Optional bool   `json:"optional"`
+	Message  string `json:"message,omitempty"`
+}
+
+// ReadinessDto reports whether the api server is ready to serve and the details of every dependency
+// optional dependencies don't affect readiness
+type ReadinessDto struct {
+	Ready  bool                `json:"ready"`
+	Checks map[string]CheckDto `json:"checks"`
+}
@@ -0,0 +1,49 @@
+// Package health internal is the domain layer for the api server readiness
+// uses the k8s discovery cache to report the state of the cluster dependencies
+package health
+
+import (
+	"github.com/kotalco/community-api/pkg/k8s"
+)
+
+type healthService struct{}
+
+type IService interface {
+	// Readiness returns the readiness of the api server and its dependencies
+	Readiness() ReadinessDto
+}
+
+var discovery = k8s.Discovery()
+
+func NewHealthService() IService {
+	return healthService{}
+}
+
+// Readiness returns the readiness of the api server and its dependencies
+// the api server is ready once the kubernetes api has been discovered successfully
+func (service healthService) Readiness() (dto ReadinessDto) {
+	dto.Checks = map[string]CheckDto{}
+
+	kubernetes := CheckDto{Status: StatusAvailable}
+	if err := discovery.Err(); err != nil {
+		kubernetes = CheckDto{Status: StatusUnavailable, Message: err.Error()}
+	} else if discovery.LastRefresh().IsZero() {
+		kubernetes = CheckDto{Status: StatusUnavailable, Message: "kubernetes api hasn't been discovered yet"}
+	}
+	dto.Checks["kubernetes"] = kubernetes
+
+	metrics := CheckDto{Status: StatusAvailable, Optional: true}
+	if !discovery.IsServed(k8s.PodMetricsResource) {
+		metrics = CheckDto{Status: StatusUnavailable, Optional: true, Message: "metrics-server isn't installed in the cluster, metrics websockets are disabled"}
+	}
+	dto.Checks["metrics"] = metrics
+
+	dto.Ready = true
+	for _, check := range dto.Checks {
+		if !check.Optional && check.Status != StatusAvailable {
+			dto.Ready = false
+		}
+	}
+
+	return
+}
@@ -10,6 +10,7 @@ import (
 
 type ClusterPeerDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	ID                   string   `json:"id"`
 	PrivatekeySecretName string   `json:"privatekeySecretName"`
@@ -24,7 +25,7 @@ type ClusterPeerDto struct {
 type ClusterPeerListDto []ClusterPeerDto
 
 func (dto ClusterPeerDto) FromIPFSClusterPeer(peer ipfsv1alpha1.ClusterPeer) ClusterPeerDto {
-	dto.Name = peer.Name
+	dto.FromObjectMeta(peer.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: peer.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.ID = peer.Spec.ID
 	dto.PrivatekeySecretName = peer.Spec.PrivateKeySecretName
@@ -18,12 +18,12 @@ import (
 type ipfsClusterPeerService struct{}
 
 type IService interface {
-	Get(name types.NamespacedName) (ipfsv1alpha1.ClusterPeer, restErrors.IRestErr)
-	Create(ClusterPeerDto) (ipfsv1alpha1.ClusterPeer, restErrors.IRestErr)
-	Update(ClusterPeerDto, *ipfsv1alpha1.ClusterPeer) restErrors.IRestErr
-	List(namespace string) (ipfsv1alpha1.ClusterPeerList, restErrors.IRestErr)
-	Delete(*ipfsv1alpha1.ClusterPeer) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(ctx context.Context, name types.NamespacedName) (ipfsv1alpha1.ClusterPeer, restErrors.IRestErr)
+	Create(context.Context, ClusterPeerDto) (ipfsv1alpha1.ClusterPeer, restErrors.IRestErr)
+	Update(context.Context, ClusterPeerDto, *ipfsv1alpha1.ClusterPeer) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (ipfsv1alpha1.ClusterPeerList, restErrors.IRestErr)
+	Delete(context.Context, *ipfsv1alpha1.ClusterPeer) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -35,13 +35,13 @@ func NewIpfsClusterPeerService() IService {
 }
 
 // Get gets a single IPFS peer by name
-func (service ipfsClusterPeerService) Get(namespacedName types.NamespacedName) (peer ipfsv1alpha1.ClusterPeer, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &peer); err != nil {
+func (service ipfsClusterPeerService) Get(ctx context.Context, namespacedName types.NamespacedName) (peer ipfsv1alpha1.ClusterPeer, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &peer); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("cluster peer by name %s doesn't exit", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get cluster peer by name %s", peer.Name))
 		return
 	}
@@ -50,7 +50,7 @@ func (service ipfsClusterPeerService) Get(namespacedName types.NamespacedName) (
 }
 
 // Create creates IPFS peer from spec
-func (service ipfsClusterPeerService) Create(dto ClusterPeerDto) (peer ipfsv1alpha1.ClusterPeer, restErr restErrors.IRestErr) {
+func (service ipfsClusterPeerService) Create(ctx context.Context, dto ClusterPeerDto) (peer ipfsv1alpha1.ClusterPeer, restErr restErrors.IRestErr) {
 	peer.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	peer.Spec = ipfsv1alpha1.ClusterPeerSpec{
 		Image: dto.Image,
@@ -109,12 +109,12 @@ func (service ipfsClusterPeerService) Create(dto ClusterPeerDto) (peer ipfsv1alp
 		peer.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &peer); err != nil {
+	if err := k8sClient.Create(ctx, &peer); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("cluster peer by name %s already exits", peer.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create cluster peer")
 		return
 	}
@@ -123,7 +123,11 @@ func (service ipfsClusterPeerService) Create(dto ClusterPeerDto) (peer ipfsv1alp
 }
 
 // Update updates IPFS peer by name from spec
-func (service ipfsClusterPeerService) Update(dto ClusterPeerDto, peer *ipfsv1alpha1.ClusterPeer) (restErr restErrors.IRestErr) {
+func (service ipfsClusterPeerService) Update(ctx context.Context, dto ClusterPeerDto, peer *ipfsv1alpha1.ClusterPeer) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&peer.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.PeerEndpoint != "" {
 		peer.Spec.PeerEndpoint = dto.PeerEndpoint
 	}
@@ -162,25 +166,25 @@ func (service ipfsClusterPeerService) Update(dto ClusterPeerDto, peer *ipfsv1alp
 			Namespace: peer.Namespace,
 			Name:      fmt.Sprintf("%s-0", peer.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), peer); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, peer); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update cluster peer by name %s", peer.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update cluster peer by name %s", peer.Name))
 			return
 		}
@@ -190,9 +194,9 @@ func (service ipfsClusterPeerService) Update(dto ClusterPeerDto, peer *ipfsv1alp
 }
 
 // List returns all IPFS peers
-func (service ipfsClusterPeerService) List(namespace string) (list ipfsv1alpha1.ClusterPeerList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service ipfsClusterPeerService) List(ctx context.Context, namespace string) (list ipfsv1alpha1.ClusterPeerList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all peers")
 		return
 	}
@@ -201,10 +205,10 @@ func (service ipfsClusterPeerService) List(namespace string) (list ipfsv1alpha1.
 }
 
 // Count returns total number of IPFS peers
-func (service ipfsClusterPeerService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service ipfsClusterPeerService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	peers := &ipfsv1alpha1.ClusterPeerList{}
-	if err := k8sClient.List(context.Background(), peers, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, peers, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to count all cluster peers")
 		return
 	}
@@ -213,9 +217,9 @@ func (service ipfsClusterPeerService) Count(namespace string) (count int, restEr
 }
 
 // Delete deletes ethereum 2.0 IPFS peer by name
-func (service ipfsClusterPeerService) Delete(peer *ipfsv1alpha1.ClusterPeer) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), peer); err != nil {
-		go logger.Error(service.Delete, err)
+func (service ipfsClusterPeerService) Delete(ctx context.Context, peer *ipfsv1alpha1.ClusterPeer) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, peer); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete cluster peer by name %s", peer.Name))
 		return
 	}
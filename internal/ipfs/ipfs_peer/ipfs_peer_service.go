@@ -18,12 +18,12 @@ import (
 type ipfsPeerService struct{}
 
 type IService interface {
-	Get(name types.NamespacedName) (ipfsv1alpha1.Peer, restErrors.IRestErr)
-	Create(PeerDto) (ipfsv1alpha1.Peer, restErrors.IRestErr)
-	Update(PeerDto, *ipfsv1alpha1.Peer) restErrors.IRestErr
-	List(namespace string) (ipfsv1alpha1.PeerList, restErrors.IRestErr)
-	Delete(*ipfsv1alpha1.Peer) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(ctx context.Context, name types.NamespacedName) (ipfsv1alpha1.Peer, restErrors.IRestErr)
+	Create(context.Context, PeerDto) (ipfsv1alpha1.Peer, restErrors.IRestErr)
+	Update(context.Context, PeerDto, *ipfsv1alpha1.Peer) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (ipfsv1alpha1.PeerList, restErrors.IRestErr)
+	Delete(context.Context, *ipfsv1alpha1.Peer) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -35,13 +35,13 @@ func NewIpfsPeerService() IService {
 }
 
 // Get gets a single IPFS peer by name
-func (service ipfsPeerService) Get(namespacedName types.NamespacedName) (peer ipfsv1alpha1.Peer, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &peer); err != nil {
+func (service ipfsPeerService) Get(ctx context.Context, namespacedName types.NamespacedName) (peer ipfsv1alpha1.Peer, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &peer); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("peer by name %s doesn't exit", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get peer by name %s", peer.Name))
 		return
 	}
@@ -50,7 +50,7 @@ func (service ipfsPeerService) Get(namespacedName types.NamespacedName) (peer ip
 }
 
 // Create creates IPFS peer from spec
-func (service ipfsPeerService) Create(dto PeerDto) (peer ipfsv1alpha1.Peer, restErr restErrors.IRestErr) {
+func (service ipfsPeerService) Create(ctx context.Context, dto PeerDto) (peer ipfsv1alpha1.Peer, restErr restErrors.IRestErr) {
 	var initProfiles []ipfsv1alpha1.Profile
 	for _, profile := range dto.InitProfiles {
 		initProfiles = append(initProfiles, ipfsv1alpha1.Profile(profile))
@@ -72,12 +72,12 @@ func (service ipfsPeerService) Create(dto PeerDto) (peer ipfsv1alpha1.Peer, rest
 		peer.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &peer); err != nil {
+	if err := k8sClient.Create(ctx, &peer); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("peer by name %s already exits", dto.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create peer")
 		return
 	}
@@ -86,7 +86,11 @@ func (service ipfsPeerService) Create(dto PeerDto) (peer ipfsv1alpha1.Peer, rest
 }
 
 // Update updates IPFS peer by name from spec
-func (service ipfsPeerService) Update(dto PeerDto, peer *ipfsv1alpha1.Peer) (restErr restErrors.IRestErr) {
+func (service ipfsPeerService) Update(ctx context.Context, dto PeerDto, peer *ipfsv1alpha1.Peer) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&peer.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.APIPort != 0 {
 		peer.Spec.APIPort = dto.APIPort
 	}
@@ -143,25 +147,25 @@ func (service ipfsPeerService) Update(dto PeerDto, peer *ipfsv1alpha1.Peer) (res
 			Namespace: peer.Namespace,
 			Name:      fmt.Sprintf("%s-0", peer.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), peer); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, peer); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update peer by name %s", peer.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update peer by name %s", peer.Name))
 			return
 		}
@@ -171,9 +175,9 @@ func (service ipfsPeerService) Update(dto PeerDto, peer *ipfsv1alpha1.Peer) (res
 }
 
 // List returns all IPFS peers
-func (service ipfsPeerService) List(namespace string) (list ipfsv1alpha1.PeerList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service ipfsPeerService) List(ctx context.Context, namespace string) (list ipfsv1alpha1.PeerList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all peers")
 		return
 	}
@@ -182,11 +186,11 @@ func (service ipfsPeerService) List(namespace string) (list ipfsv1alpha1.PeerLis
 }
 
 // Count returns total number of IPFS peers
-func (service ipfsPeerService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service ipfsPeerService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	peers := &ipfsv1alpha1.PeerList{}
 
-	if err := k8sClient.List(context.Background(), peers, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, peers, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to count all peers")
 		return
 	}
@@ -195,9 +199,9 @@ func (service ipfsPeerService) Count(namespace string) (count int, restErr restE
 }
 
 // Delete deletes ethereum 2.0 IPFS peer by name
-func (service ipfsPeerService) Delete(peer *ipfsv1alpha1.Peer) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), peer); err != nil {
-		go logger.Error(service.Delete, err)
+func (service ipfsPeerService) Delete(ctx context.Context, peer *ipfsv1alpha1.Peer) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, peer); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete peer by name %s", peer.Name))
 		return
 	}
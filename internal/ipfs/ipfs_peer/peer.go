@@ -12,6 +12,7 @@ import (
 // TODO: update with SwarmKeySecret and Resources
 type PeerDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	InitProfiles []string `json:"initProfiles"`
 	APIPort      uint     `json:"apiPort"`
@@ -40,7 +41,7 @@ func (dto PeerDto) FromIPFSPeer(peer ipfsv1alpha1.Peer) PeerDto {
 		profiles = append(profiles, string(profile))
 	}
 
-	dto.Name = peer.Name
+	dto.FromObjectMeta(peer.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: peer.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.APIPort = peer.Spec.APIPort
 	dto.GatewayPort = peer.Spec.GatewayPort
@@ -0,0 +1,125 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestDto is the clean manifest of a resource and the names of the secrets it references
+// secrets aren't exported, they must exist before the manifest is applied
+type ManifestDto struct {
+	Manifest map[string]interface{}
+	Secrets  []string
+}
+
+// ExportDto is the clean manifests of many resources and the names of the secrets they reference
+type ExportDto struct {
+	Manifests []map[string]interface{}
+	Secrets   []string
+}
+
+// YAML returns the manifest as a yaml document preceded by a comment listing the referenced secrets
+func (dto ManifestDto) YAML() ([]byte, error) {
+	return ExportDto{Manifests: []map[string]interface{}{dto.Manifest}, Secrets: dto.Secrets}.YAML()
+}
+
+// JSON returns the manifest as a json object
+func (dto ManifestDto) JSON() ([]byte, error) {
+	return json.MarshalIndent(dto.Manifest, "", "  ")
+}
+
+// YAML returns the manifests as a multi-document yaml preceded by a comment listing the referenced secrets
+func (dto ExportDto) YAML() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if len(dto.Secrets) > 0 {
+		fmt.Fprintf(buf, "# referenced secrets, not exported: %s\n", strings.Join(dto.Secrets, ", "))
+	}
+	for i, manifest := range dto.Manifests {
+		doc, err := yaml.Marshal(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(doc)
+	}
+	return buf.Bytes(), nil
+}
+
+// JSON returns the manifests as a json kubernetes list
+func (dto ExportDto) JSON() ([]byte, error) {
+	items := dto.Manifests
+	if items == nil {
+		items = []map[string]interface{}{}
+	}
+	return json.MarshalIndent(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	}, "", "  ")
+}
+
+// conflict policies applied when an imported resource already exists
+const (
+	ConflictSkip      = "skip"
+	ConflictOverwrite = "overwrite"
+	ConflictFail      = "fail"
+)
+
+// actions taken for an imported document
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionSkip   = "skip"
+)
+
+// statuses of an imported document
+const (
+	StatusValid    = "valid"
+	StatusInvalid  = "invalid"
+	StatusConflict = "conflict"
+	StatusApplied  = "applied"
+	StatusFailed   = "failed"
+)
+
+// ImportOptions are the options of an import
+type ImportOptions struct {
+	Namespace string
+	// Apply applies the documents if all of them are valid, documents are only validated otherwise
+	Apply bool
+	// Conflict is the policy for documents of resources that already exist
+	Conflict string
+}
+
+// ImportDto is the outcome of importing manifests, documents are applied only if all of them are valid
+type ImportDto struct {
+	Applied bool              `json:"applied"`
+	Results []ImportResultDto `json:"results"`
+}
+
+// ImportResultDto is the outcome of importing a document, Document is its position starting at 1
+type ImportResultDto struct {
+	Document   int      `json:"document"`
+	APIVersion string   `json:"apiVersion,omitempty"`
+	Kind       string   `json:"kind,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Action     string   `json:"action,omitempty"`
+	Status     string   `json:"status"`
+	Errors     []string `json:"errors,omitempty"`
+	Link       string   `json:"link,omitempty"`
+}
+
+// Failed reports whether any document is invalid, conflicting or failed to be applied
+func (dto ImportDto) Failed() bool {
+	for _, result := range dto.Results {
+		if len(result.Errors) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,293 @@
+// Package manifest internal is the domain layer for manifests
+// exports resources as clean manifests to be kept in git, and imports them back
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kotalco/community-api/internal/core/name"
+	"github.com/kotalco/community-api/internal/project"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxDocuments is the maximum number of documents of an import
+	maxDocuments = 100
+	// fieldManager owns the fields of imported resources
+	fieldManager = "kotal-api"
+)
+
+type manifestService struct{}
+
+type IService interface {
+	Get(ctx context.Context, kind k8s.Kind, name types.NamespacedName) (ManifestDto, restErrors.IRestErr)
+	Export(ctx context.Context, namespace, project string) (ExportDto, restErrors.IRestErr)
+	Import(ctx context.Context, body []byte, opts ImportOptions) (ImportDto, restErrors.IRestErr)
+}
+
+var (
+	k8sClient      = k8s.NewClientService()
+	projectService = project.NewProjectService()
+	nameService    = name.NewNameService()
+)
+
+func NewManifestService() IService {
+	return manifestService{}
+}
+
+// Get returns the manifest of a resource of kind by name
+func (service manifestService) Get(ctx context.Context, kind k8s.Kind, name types.NamespacedName) (ManifestDto, restErrors.IRestErr) {
+	obj := kind.NewObject()
+	if err := k8sClient.Get(ctx, name, obj); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return ManifestDto{}, restErrors.NewNotFoundError(fmt.Sprintf("%s by name %s doesn't exist", kind.Kind, name.Name))
+		}
+		logger.ErrorContext(ctx, service.Get, err)
+		return ManifestDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't get %s by name %s", kind.Kind, name.Name))
+	}
+
+	manifest, err := k8s.Manifest(kind, obj)
+	if err != nil {
+		logger.ErrorContext(ctx, service.Get, err)
+		return ManifestDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't export %s by name %s", kind.Kind, name.Name))
+	}
+	return ManifestDto{Manifest: manifest, Secrets: k8s.ReferencedSecrets(manifest)}, nil
+}
+
+// Export returns the manifests of all kotal resources in namespace, or of the members of a project if it's not empty
+// manifests are ordered by kind then name so exports of the same resources are identical
+func (service manifestService) Export(ctx context.Context, namespace, projectName string) (ExportDto, restErrors.IRestErr) {
+	if projectName != "" {
+		if _, restErr := projectService.Get(ctx, types.NamespacedName{Namespace: namespace, Name: projectName}); restErr != nil {
+			return ExportDto{}, restErr
+		}
+	}
+
+	kinds, err := k8s.ListKotalKinds(ctx, namespace)
+	if err != nil {
+		logger.ErrorContext(ctx, service.Export, err)
+		return ExportDto{}, restErrors.NewInternalServerError("can't list resources")
+	}
+	if projectName != "" {
+		kinds = project.Members(kinds, projectName)
+	}
+
+	dto := ExportDto{Manifests: make([]map[string]interface{}, 0)}
+	secrets := map[string]bool{}
+	for _, kind := range kinds {
+		sort.Slice(kind.Objects, func(i, j int) bool { return kind.Objects[i].GetName() < kind.Objects[j].GetName() })
+		for _, obj := range kind.Objects {
+			manifest, err := k8s.Manifest(kind.Kind, obj)
+			if err != nil {
+				logger.ErrorContext(ctx, service.Export, err)
+				return ExportDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't export %s by name %s", kind.Kind.Kind, obj.GetName()))
+			}
+			dto.Manifests = append(dto.Manifests, manifest)
+			for _, secret := range k8s.ReferencedSecrets(manifest) {
+				secrets[secret] = true
+			}
+		}
+	}
+
+	dto.Secrets = make([]string, 0, len(secrets))
+	for secret := range secrets {
+		dto.Secrets = append(dto.Secrets, secret)
+	}
+	sort.Strings(dto.Secrets)
+	return dto, nil
+}
+
+// Import validates the kotal manifests of a yaml or json document stream, then applies them if opts.Apply is set
+// documents are validated against the webhooks of their kind with a server side dry run
+// nothing is applied if any document is invalid or conflicts with an existing resource
+func (service manifestService) Import(ctx context.Context, body []byte, opts ImportOptions) (ImportDto, restErrors.IRestErr) {
+	manifests, err := k8s.ParseManifests(body)
+	if err != nil {
+		return ImportDto{}, restErrors.NewBadRequestError(fmt.Sprintf("invalid manifests: %s", err.Error()))
+	}
+	if len(manifests) == 0 || len(manifests) > maxDocuments {
+		return ImportDto{}, restErrors.NewBadRequestError(fmt.Sprintf("manifests must have between 1 and %d documents", maxDocuments))
+	}
+
+	dto := ImportDto{Results: make([]ImportResultDto, len(manifests))}
+	objects := make([]*unstructured.Unstructured, len(manifests))
+	names := map[string]int{}
+	for i, manifest := range manifests {
+		dto.Results[i], objects[i] = service.validate(ctx, i+1, manifest, opts, names)
+	}
+	if !opts.Apply || dto.Failed() {
+		return dto, nil
+	}
+
+	for i, obj := range objects {
+		result := &dto.Results[i]
+		if result.Action == ActionSkip {
+			continue
+		}
+		if err := service.apply(ctx, obj.DeepCopy(), false); err != nil {
+			logger.ErrorContext(ctx, service.Import, err)
+			result.Status = StatusFailed
+			result.Errors = []string{err.Error()}
+			continue
+		}
+		result.Status = StatusApplied
+	}
+	dto.Applied = true
+
+	return dto, nil
+}
+
+// validate validates a document and returns its result and the object to apply
+// names maps the names of the documents validated so far to their position
+func (service manifestService) validate(ctx context.Context, document int, manifest map[string]interface{}, opts ImportOptions, names map[string]int) (ImportResultDto, *unstructured.Unstructured) {
+	obj := &unstructured.Unstructured{Object: manifest}
+	result := ImportResultDto{Document: document, APIVersion: obj.GetAPIVersion(), Kind: obj.GetKind(), Name: obj.GetName(), Status: StatusInvalid}
+
+	gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+	if err != nil {
+		result.Errors = []string{fmt.Sprintf("invalid apiVersion %s", obj.GetAPIVersion())}
+		return result, nil
+	}
+	kind, ok := k8s.KindFor(gv.WithKind(obj.GetKind()))
+	if !ok || kind.Protocol == "" {
+		result.Errors = []string{fmt.Sprintf("unsupported kind %s %s", obj.GetAPIVersion(), obj.GetKind())}
+		return result, nil
+	}
+	if !kind.IsServed() {
+		result.Errors = []string{fmt.Sprintf("%s %s isn't installed in the cluster", obj.GetAPIVersion(), obj.GetKind())}
+		return result, nil
+	}
+	result.Link = fmt.Sprintf("/api/v1/%s/%s/%s", kind.Protocol, kind.Resource, obj.GetName())
+
+	typed := kind.NewObject()
+	if err = decodeStrict(manifest, typed); err != nil {
+		result.Errors = []string{err.Error()}
+		return result, nil
+	}
+	if namespace := obj.GetNamespace(); namespace != "" && namespace != opts.Namespace {
+		result.Errors = []string{fmt.Sprintf("namespace %s doesn't match the import namespace %s", namespace, opts.Namespace)}
+		return result, nil
+	}
+
+	meta := k8s.MetaDataDto{}
+	meta.FromObjectMeta(metav1.ObjectMeta{Name: typed.GetName(), Labels: typed.GetLabels(), Annotations: typed.GetAnnotations()})
+	if restErr := meta.Validate(); restErr != nil {
+		result.Errors = validationErrors(restErr)
+		return result, nil
+	}
+	if previous, ok := names[obj.GetName()]; ok {
+		result.Errors = []string{fmt.Sprintf("name %s is used by document %d", obj.GetName(), previous)}
+		return result, nil
+	}
+	names[obj.GetName()] = document
+
+	k8s.CleanManifest(manifest)
+	obj.SetNamespace(opts.Namespace)
+
+	nameDto, restErr := nameService.Get(ctx, types.NamespacedName{Namespace: opts.Namespace, Name: obj.GetName()})
+	if restErr != nil {
+		result.Status = StatusFailed
+		result.Errors = []string{restErr.Error()}
+		return result, nil
+	}
+	exists := false
+	for _, usedBy := range nameDto.UsedBy {
+		if usedBy.APIVersion == result.APIVersion && usedBy.Kind == result.Kind {
+			exists = true
+		}
+	}
+
+	switch {
+	case !exists && !nameDto.Available:
+		result.Status = StatusConflict
+		result.Errors = []string{fmt.Sprintf("name %s is used by a %s", obj.GetName(), nameDto.UsedBy[0].Kind)}
+		return result, nil
+	case !exists:
+		result.Action = ActionCreate
+	case opts.Conflict == ConflictSkip:
+		result.Action = ActionSkip
+		result.Status = StatusValid
+		return result, obj
+	case opts.Conflict == ConflictOverwrite:
+		result.Action = ActionUpdate
+	default:
+		result.Status = StatusConflict
+		result.Errors = []string{fmt.Sprintf("%s %s already exists", kind.Kind, obj.GetName())}
+		return result, nil
+	}
+
+	applied := obj.DeepCopy()
+	if err = service.apply(ctx, applied, true); err != nil {
+		if !apiErrors.IsInvalid(err) && !apiErrors.IsBadRequest(err) {
+			logger.ErrorContext(ctx, service.validate, err)
+			result.Status = StatusFailed
+		}
+		result.Errors = []string{err.Error()}
+		return result, nil
+	}
+
+	// the kotal operator copies the labels of a resource into the immutable selector of its statefulset
+	if result.Action == ActionUpdate {
+		existing := kind.NewObject()
+		if err = k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+			logger.ErrorContext(ctx, service.validate, err)
+			result.Status = StatusFailed
+			result.Errors = []string{err.Error()}
+			return result, nil
+		}
+		if k8s.LabelsChanged(existing.GetLabels(), applied.GetLabels()) {
+			result.Errors = []string{fmt.Sprintf("labels of %s %s can't be changed after it's created", kind.Kind, obj.GetName())}
+			return result, nil
+		}
+	}
+
+	result.Status = StatusValid
+	return result, obj
+}
+
+// apply applies obj with server side apply, taking over the fields owned by other managers
+// obj is set to the applied object
+func (service manifestService) apply(ctx context.Context, obj *unstructured.Unstructured, dryRun bool) error {
+	opts := []client.PatchOption{client.FieldOwner(fieldManager), client.ForceOwnership}
+	if dryRun {
+		opts = append(opts, client.DryRunAll)
+	}
+	return k8sClient.Patch(ctx, obj, client.Apply, opts...)
+}
+
+// decodeStrict decodes manifest into obj, unknown fields are rejected
+func decodeStrict(manifest map[string]interface{}, obj client.Object) error {
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(obj)
+}
+
+// validationErrors returns the validation errors of restErr as field: message
+func validationErrors(restErr restErrors.IRestErr) []string {
+	rest, ok := restErr.(restErrors.RestErr)
+	if !ok || len(rest.Validations) == 0 {
+		return []string{restErr.Error()}
+	}
+	errs := make([]string, 0, len(rest.Validations))
+	for field, message := range rest.Validations {
+		errs = append(errs, fmt.Sprintf("%s: %s", field, message))
+	}
+	sort.Strings(errs)
+	return errs
+}
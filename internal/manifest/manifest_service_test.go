@@ -0,0 +1,205 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kotalco/community-api/internal/core/name"
+	"github.com/kotalco/community-api/internal/testutil"
+	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// applyClient is a fake client recording server side applies, the fake client doesn't support them
+// applies of the objects named in invalid are rejected like an admission webhook does
+type applyClient struct {
+	client.Client
+	invalid map[string]bool
+	dryRuns []string
+	applied []string
+}
+
+func (c *applyClient) Patch(_ context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch != client.Apply {
+		return fmt.Errorf("unexpected patch type %s", patch.Type())
+	}
+	if c.invalid[obj.GetName()] {
+		return apiErrors.NewInvalid(schema.GroupKind{Group: "ipfs.kotal.io", Kind: "Peer"}, obj.GetName(), field.ErrorList{field.Invalid(field.NewPath("spec", "routing"), "none", "unsupported routing")})
+	}
+
+	patchOpts := &client.PatchOptions{}
+	patchOpts.ApplyOptions(opts)
+	if len(patchOpts.DryRun) > 0 {
+		c.dryRuns = append(c.dryRuns, obj.GetName())
+		return nil
+	}
+	c.applied = append(c.applied, obj.GetName())
+	return nil
+}
+
+// usedByPeer and usedBySecret are the kinds the name service mock reports a name used by
+var (
+	usedByPeer   = name.UsedByDto{APIVersion: "ipfs.kotal.io/v1alpha1", Kind: "Peer"}
+	usedBySecret = name.UsedByDto{APIVersion: "v1", Kind: "Secret"}
+)
+
+func newClient(t *testing.T, names testutil.NameService, objects ...client.Object) *applyClient {
+	recording := &applyClient{Client: testutil.NewClient(t, objects...)}
+	k8sClient = recording
+	nameService = names
+	return recording
+}
+
+// peers returns a yaml document stream of ipfs peers by name
+func peers(names ...string) []byte {
+	docs := make([]string, len(names))
+	for i, n := range names {
+		docs[i] = fmt.Sprintf("apiVersion: ipfs.kotal.io/v1alpha1\nkind: Peer\nmetadata:\n  name: %s\nspec:\n  api: true\n", n)
+	}
+	return []byte(strings.Join(docs, "---\n"))
+}
+
+// peer returns an existing ipfs peer with labels
+func peer(name string, labels map[string]string) *ipfsv1alpha1.Peer {
+	return &ipfsv1alpha1.Peer{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+}
+
+func statuses(dto ImportDto) []string {
+	result := make([]string, len(dto.Results))
+	for i, r := range dto.Results {
+		result[i] = r.Status
+	}
+	return result
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	existing := testutil.NameService{Used: map[string]name.UsedByDto{"peer-1": usedByPeer}}
+
+	t.Run("validate only", func(t *testing.T) {
+		recording := newClient(t, testutil.NameService{})
+		dto, err := NewManifestService().Import(ctx, peers("peer-1", "peer-2"), ImportOptions{Namespace: "default"})
+		assert.Nil(t, err)
+		assert.False(t, dto.Applied)
+		assert.Equal(t, []string{StatusValid, StatusValid}, statuses(dto))
+		assert.Equal(t, ActionCreate, dto.Results[0].Action)
+		assert.Equal(t, "/api/v1/ipfs/peers/peer-1", dto.Results[0].Link)
+		assert.Equal(t, []string{"peer-1", "peer-2"}, recording.dryRuns)
+		assert.Empty(t, recording.applied)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		recording := newClient(t, testutil.NameService{})
+		dto, err := NewManifestService().Import(ctx, peers("peer-1", "peer-2"), ImportOptions{Namespace: "default", Apply: true})
+		assert.Nil(t, err)
+		assert.True(t, dto.Applied)
+		assert.Equal(t, []string{StatusApplied, StatusApplied}, statuses(dto))
+		assert.Equal(t, []string{"peer-1", "peer-2"}, recording.applied)
+	})
+
+	t.Run("skip conflicts", func(t *testing.T) {
+		recording := newClient(t, existing)
+		dto, err := NewManifestService().Import(ctx, peers("peer-1", "peer-2"), ImportOptions{Namespace: "default", Apply: true, Conflict: ConflictSkip})
+		assert.Nil(t, err)
+		assert.True(t, dto.Applied)
+		assert.Equal(t, ActionSkip, dto.Results[0].Action)
+		assert.Equal(t, []string{StatusValid, StatusApplied}, statuses(dto))
+		assert.Equal(t, []string{"peer-2"}, recording.dryRuns)
+		assert.Equal(t, []string{"peer-2"}, recording.applied)
+	})
+
+	t.Run("overwrite conflicts", func(t *testing.T) {
+		recording := newClient(t, existing, peer("peer-1", nil))
+		dto, err := NewManifestService().Import(ctx, peers("peer-1", "peer-2"), ImportOptions{Namespace: "default", Apply: true, Conflict: ConflictOverwrite})
+		assert.Nil(t, err)
+		assert.True(t, dto.Applied)
+		assert.Equal(t, ActionUpdate, dto.Results[0].Action)
+		assert.Equal(t, ActionCreate, dto.Results[1].Action)
+		assert.Equal(t, []string{StatusApplied, StatusApplied}, statuses(dto))
+		assert.Equal(t, []string{"peer-1", "peer-2"}, recording.applied)
+	})
+
+	t.Run("labels can't be changed on overwrite", func(t *testing.T) {
+		recording := newClient(t, existing, peer("peer-1", map[string]string{"team": "core"}))
+		body := "apiVersion: ipfs.kotal.io/v1alpha1\nkind: Peer\nmetadata:\n  name: peer-1\n  labels:\n    team: infra\nspec:\n  api: true\n"
+		dto, err := NewManifestService().Import(ctx, []byte(body), ImportOptions{Namespace: "default", Apply: true, Conflict: ConflictOverwrite})
+		assert.Nil(t, err)
+		assert.False(t, dto.Applied)
+		assert.Equal(t, []string{StatusInvalid}, statuses(dto))
+		assert.Equal(t, []string{"labels of Peer peer-1 can't be changed after it's created"}, dto.Results[0].Errors)
+		assert.Empty(t, recording.applied)
+	})
+
+	t.Run("fail conflicts", func(t *testing.T) {
+		recording := newClient(t, existing)
+		dto, err := NewManifestService().Import(ctx, peers("peer-1", "peer-2"), ImportOptions{Namespace: "default", Apply: true, Conflict: ConflictFail})
+		assert.Nil(t, err)
+		assert.False(t, dto.Applied)
+		assert.Equal(t, []string{StatusConflict, StatusValid}, statuses(dto))
+		assert.Equal(t, []string{"Peer peer-1 already exists"}, dto.Results[0].Errors)
+		assert.Empty(t, recording.applied)
+	})
+
+	t.Run("name used by another kind", func(t *testing.T) {
+		recording := newClient(t, testutil.NameService{Used: map[string]name.UsedByDto{"peer-1": usedBySecret}})
+		dto, err := NewManifestService().Import(ctx, peers("peer-1"), ImportOptions{Namespace: "default", Apply: true, Conflict: ConflictOverwrite})
+		assert.Nil(t, err)
+		assert.False(t, dto.Applied)
+		assert.Equal(t, []string{StatusConflict}, statuses(dto))
+		assert.Empty(t, recording.applied)
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		recording := newClient(t, testutil.NameService{})
+		dto, err := NewManifestService().Import(ctx, peers("peer-1", "peer-2", "peer-1"), ImportOptions{Namespace: "default", Apply: true})
+		assert.Nil(t, err)
+		assert.False(t, dto.Applied)
+		assert.Equal(t, []string{StatusValid, StatusValid, StatusInvalid}, statuses(dto))
+		assert.Equal(t, []string{"name peer-1 is used by document 1"}, dto.Results[2].Errors)
+		assert.Empty(t, recording.applied)
+	})
+
+	t.Run("nothing is applied if a document is invalid", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			body    string
+			invalid map[string]bool
+		}{
+			{"rejected by the webhook", string(peers("peer-1", "peer-2")), map[string]bool{"peer-2": true}},
+			{"unknown field", string(peers("peer-1")) + "---\napiVersion: ipfs.kotal.io/v1alpha1\nkind: Peer\nmetadata:\n  name: peer-2\nspec:\n  replicas: 3\n", nil},
+			{"unsupported kind", string(peers("peer-1")) + "---\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: peer-2\n", nil},
+			{"other namespace", string(peers("peer-1")) + "---\napiVersion: ipfs.kotal.io/v1alpha1\nkind: Peer\nmetadata:\n  name: peer-2\n  namespace: kube-system\n", nil},
+			{"invalid name", string(peers("peer-1", "Peer_2")), nil},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				recording := newClient(t, testutil.NameService{})
+				recording.invalid = test.invalid
+				dto, err := NewManifestService().Import(ctx, []byte(test.body), ImportOptions{Namespace: "default", Apply: true})
+				assert.Nil(t, err)
+				assert.False(t, dto.Applied)
+				assert.Equal(t, StatusValid, dto.Results[0].Status)
+				assert.Equal(t, StatusInvalid, dto.Results[1].Status)
+				assert.NotEmpty(t, dto.Results[1].Errors)
+				assert.Empty(t, recording.applied)
+			})
+		}
+	})
+
+	t.Run("document count", func(t *testing.T) {
+		newClient(t, testutil.NameService{})
+		for _, body := range [][]byte{[]byte(""), peers(make([]string, maxDocuments+1)...)} {
+			_, err := NewManifestService().Import(ctx, body, ImportOptions{Namespace: "default"})
+			assert.NotNil(t, err)
+			assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+		}
+	})
+}
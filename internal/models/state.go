@@ -0,0 +1,13 @@
+package models
+
+import "github.com/kotalco/community-api/pkg/k8s"
+
+// State holds the status of a resource computed from its statefulset and pod
+type State struct {
+	Status *k8s.StatusDto `json:"status,omitempty"`
+}
+
+// SetStatus sets the status of the resource
+func (state *State) SetStatus(status k8s.StatusDto) {
+	state.Status = &status
+}
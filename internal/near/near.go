@@ -11,6 +11,7 @@ import (
 // NearDto is NEAR node
 type NearDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network                  string    `json:"network"`
 	Archive                  bool      `json:"archive"`
@@ -31,7 +32,7 @@ type NearListDto []NearDto
 
 // FromNEARNode creates node model from NEAR node
 func (dto NearDto) FromNEARNode(node nearv1alpha1.Node) NearDto {
-	dto.Name = node.Name
+	dto.FromObjectMeta(node.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: node.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Network = string(node.Spec.Network)
 	dto.Archive = node.Spec.Archive
@@ -18,12 +18,12 @@ import (
 type nearService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (nearv1alpha1.Node, restErrors.IRestErr)
-	Create(NearDto) (nearv1alpha1.Node, restErrors.IRestErr)
-	Update(NearDto, *nearv1alpha1.Node) restErrors.IRestErr
-	List(namespace string) (nearv1alpha1.NodeList, restErrors.IRestErr)
-	Delete(*nearv1alpha1.Node) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (nearv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, NearDto) (nearv1alpha1.Node, restErrors.IRestErr)
+	Update(context.Context, NearDto, *nearv1alpha1.Node) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (nearv1alpha1.NodeList, restErrors.IRestErr)
+	Delete(context.Context, *nearv1alpha1.Node) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -35,13 +35,13 @@ func NewNearService() IService {
 }
 
 // Get gets a single near node by name
-func (service nearService) Get(namespacedName types.NamespacedName) (node nearv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service nearService) Get(ctx context.Context, namespacedName types.NamespacedName) (node nearv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exit", namespacedName))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName))
 		return
 	}
@@ -50,7 +50,7 @@ func (service nearService) Get(namespacedName types.NamespacedName) (node nearv1
 }
 
 // Create creates near node from spec
-func (service nearService) Create(dto NearDto) (node nearv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service nearService) Create(ctx context.Context, dto NearDto) (node nearv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = nearv1alpha1.NodeSpec{
 		Network: dto.Network,
@@ -68,12 +68,12 @@ func (service nearService) Create(dto NearDto) (node nearv1alpha1.Node, restErr
 		node.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s already exits", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -82,7 +82,10 @@ func (service nearService) Create(dto NearDto) (node nearv1alpha1.Node, restErr
 }
 
 // Update updates near node by name from spec
-func (service nearService) Update(dto NearDto, node *nearv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service nearService) Update(ctx context.Context, dto NearDto, node *nearv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
 
 	if dto.NodePrivateKeySecretName != nil {
 		node.Spec.NodePrivateKeySecretName = *dto.NodePrivateKeySecretName
@@ -151,25 +154,25 @@ func (service nearService) Update(dto NearDto, node *nearv1alpha1.Node) (restErr
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -179,9 +182,9 @@ func (service nearService) Update(dto NearDto, node *nearv1alpha1.Node) (restErr
 }
 
 // List returns all near nodes
-func (service nearService) List(namespace string) (list nearv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service nearService) List(ctx context.Context, namespace string) (list nearv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -190,10 +193,10 @@ func (service nearService) List(namespace string) (list nearv1alpha1.NodeList, r
 }
 
 // Count returns total number of near nodes
-func (service nearService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service nearService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &nearv1alpha1.NodeList{}
-	if err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, nodes, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to count all nodes")
 		return
 	}
@@ -202,9 +205,9 @@ func (service nearService) Count(namespace string) (count int, restErr restError
 }
 
 // Delete deletes ethereum 2.0 near node by name
-func (service nearService) Delete(node *nearv1alpha1.Node) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), node); err != nil {
-		go logger.Error(service.Delete, err)
+func (service nearService) Delete(ctx context.Context, node *nearv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 		return
 	}
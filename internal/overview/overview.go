@@ -0,0 +1,36 @@
+package overview
+
+// OverviewDto aggregates the kotal resources of a namespace
+type OverviewDto struct {
+	Protocols []ProtocolDto  `json:"protocols"`
+	Totals    ResourcesDto   `json:"totals"`
+	Unhealthy []UnhealthyDto `json:"unhealthy"`
+}
+
+// ProtocolDto counts the resources of a protocol by state and sums their requested resources
+type ProtocolDto struct {
+	Protocol  string       `json:"protocol"`
+	Total     int          `json:"total"`
+	Running   int          `json:"running"`
+	Pending   int          `json:"pending"`
+	Error     int          `json:"error"`
+	Resources ResourcesDto `json:"resources"`
+}
+
+// ResourcesDto is the sum of requested cpu, memory and storage
+type ResourcesDto struct {
+	CPU     string `json:"cpu"`
+	Memory  string `json:"memory"`
+	Storage string `json:"storage"`
+}
+
+// UnhealthyDto is a resource that isn't running and the reason
+type UnhealthyDto struct {
+	Protocol string `json:"protocol"`
+	Resource string `json:"resource"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	Reason   string `json:"reason"`
+	Link     string `json:"link"`
+}
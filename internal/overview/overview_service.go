@@ -0,0 +1,178 @@
+// Package overview internal is the domain layer for the namespace overview
+// aggregates the state and requested resources of every kotal resource in the namespace
+package overview
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/logger"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// cacheTTL is how long the overview of a namespace is reused
+const cacheTTL = 5 * time.Second
+
+type cached struct {
+	overview  OverviewDto
+	expiresAt time.Time
+}
+
+type overviewService struct {
+	lock  *sync.Mutex
+	cache map[string]cached
+}
+
+type IService interface {
+	Get(ctx context.Context, namespace string) (OverviewDto, restErrors.IRestErr)
+}
+
+var (
+	statefulSetService = statefulset.NewService()
+)
+
+func NewOverviewService() IService {
+	return overviewService{lock: &sync.Mutex{}, cache: map[string]cached{}}
+}
+
+// Get returns the overview of namespace, computed at most once every cacheTTL
+func (service overviewService) Get(ctx context.Context, namespace string) (OverviewDto, restErrors.IRestErr) {
+	service.lock.Lock()
+	entry, ok := service.cache[namespace]
+	service.lock.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.overview, nil
+	}
+
+	overview, err := service.compute(ctx, namespace)
+	if err != nil {
+		return OverviewDto{}, err
+	}
+
+	service.lock.Lock()
+	service.cache[namespace] = cached{overview: overview, expiresAt: time.Now().Add(cacheTTL)}
+	service.lock.Unlock()
+
+	return overview, nil
+}
+
+// compute lists the kotal resources and their statuses concurrently then aggregates them by protocol
+func (service overviewService) compute(ctx context.Context, namespace string) (OverviewDto, restErrors.IRestErr) {
+	var (
+		wg          sync.WaitGroup
+		kinds       []k8s.KindObjects
+		kindsErr    error
+		statuses    k8s.Statuses
+		statusesErr restErrors.IRestErr
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		kinds, kindsErr = k8s.ListKotalKinds(ctx, namespace)
+	}()
+	go func() {
+		defer wg.Done()
+		statuses, statusesErr = statefulSetService.Statuses(ctx, namespace)
+	}()
+	wg.Wait()
+
+	if kindsErr != nil {
+		logger.ErrorContext(ctx, service.Get, kindsErr)
+		return OverviewDto{}, restErrors.NewInternalServerError("can't get overview")
+	}
+	if statusesErr != nil {
+		return OverviewDto{}, statusesErr
+	}
+
+	overview := OverviewDto{Protocols: []ProtocolDto{}, Unhealthy: []UnhealthyDto{}}
+	// kinds of the same protocol like beacon nodes and validators are aggregated together
+	protocols := map[string]int{}
+	requests := []quantities{}
+	for _, kind := range kinds {
+		index, ok := protocols[kind.Kind.Protocol]
+		if !ok {
+			index = len(overview.Protocols)
+			protocols[kind.Kind.Protocol] = index
+			overview.Protocols = append(overview.Protocols, ProtocolDto{Protocol: kind.Kind.Protocol})
+			requests = append(requests, newQuantities())
+		}
+		protocol := &overview.Protocols[index]
+
+		for _, obj := range kind.Objects {
+			protocol.Total++
+			status := statuses.Of(obj.GetName())
+			switch status.Phase {
+			case k8s.StateRunning:
+				protocol.Running++
+			case k8s.StateError:
+				protocol.Error++
+			default:
+				protocol.Pending++
+			}
+			if status.Phase != k8s.StateRunning {
+				overview.Unhealthy = append(overview.Unhealthy, UnhealthyDto{
+					Protocol: kind.Kind.Protocol,
+					Resource: kind.Kind.Resource,
+					Kind:     kind.Kind.Kind,
+					Name:     obj.GetName(),
+					State:    status.Phase,
+					Reason:   status.Reason,
+					Link:     fmt.Sprintf("/api/v1/%s/%s/%s", kind.Kind.Protocol, kind.Kind.Resource, obj.GetName()),
+				})
+			}
+			requests[index].add(obj)
+		}
+	}
+
+	totals := newQuantities()
+	for index := range overview.Protocols {
+		overview.Protocols[index].Resources = requests[index].dto()
+		totals.addAll(requests[index])
+	}
+	overview.Totals = totals.dto()
+
+	return overview, nil
+}
+
+// quantities sums the cpu, memory and storage requested by resources
+type quantities map[string]*resource.Quantity
+
+func newQuantities() quantities {
+	return quantities{
+		"cpu":     resource.NewQuantity(0, resource.DecimalSI),
+		"memory":  resource.NewQuantity(0, resource.BinarySI),
+		"storage": resource.NewQuantity(0, resource.BinarySI),
+	}
+}
+
+// add adds the spec.resources requests of obj, requests that can't be parsed are skipped
+func (q quantities) add(obj client.Object) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+	for name, sum := range q {
+		value, _, _ := unstructured.NestedString(content, "spec", "resources", name)
+		if quantity, err := resource.ParseQuantity(value); err == nil {
+			sum.Add(quantity)
+		}
+	}
+}
+
+func (q quantities) addAll(other quantities) {
+	for name, sum := range q {
+		sum.Add(*other[name])
+	}
+}
+
+func (q quantities) dto() ResourcesDto {
+	return ResourcesDto{CPU: q["cpu"].String(), Memory: q["memory"].String(), Storage: q["storage"].String()}
+}
@@ -10,6 +10,7 @@ import (
 
 type PolkadotDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Network                  string   `json:"network"`
 	NodePrivateKeySecretName *string  `json:"nodePrivateKeySecretName"`
@@ -36,7 +37,7 @@ type PolkadotListDto []PolkadotDto
 
 func (dto PolkadotDto) FromPolkadotNode(node polkadotv1alpha1.Node) PolkadotDto {
 	dto.Time = models.Time{CreatedAt: node.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
-	dto.Name = node.Name
+	dto.FromObjectMeta(node.ObjectMeta)
 	dto.Network = node.Spec.Network
 	dto.NodePrivateKeySecretName = &node.Spec.NodePrivateKeySecretName
 	dto.Validator = &node.Spec.Validator
@@ -18,12 +18,12 @@ import (
 type polkadtoService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (polkadotv1alpha1.Node, restErrors.IRestErr)
-	Create(PolkadotDto) (polkadotv1alpha1.Node, restErrors.IRestErr)
-	Update(PolkadotDto, *polkadotv1alpha1.Node) restErrors.IRestErr
-	List(namespace string) (polkadotv1alpha1.NodeList, restErrors.IRestErr)
-	Delete(*polkadotv1alpha1.Node) restErrors.IRestErr
-	Count(namespace string) (int, restErrors.IRestErr)
+	Get(context.Context, types.NamespacedName) (polkadotv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, PolkadotDto) (polkadotv1alpha1.Node, restErrors.IRestErr)
+	Update(context.Context, PolkadotDto, *polkadotv1alpha1.Node) restErrors.IRestErr
+	List(ctx context.Context, namespace string) (polkadotv1alpha1.NodeList, restErrors.IRestErr)
+	Delete(context.Context, *polkadotv1alpha1.Node) restErrors.IRestErr
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
 }
 
 var (
@@ -35,13 +35,13 @@ func NewPolkadotService() IService {
 }
 
 // Get gets a single polkadot node by name
-func (service polkadtoService) Get(namespacedName types.NamespacedName) (node polkadotv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service polkadtoService) Get(ctx context.Context, namespacedName types.NamespacedName) (node polkadotv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exits", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
@@ -50,7 +50,7 @@ func (service polkadtoService) Get(namespacedName types.NamespacedName) (node po
 }
 
 // Create creates polkadot node from spec
-func (service polkadtoService) Create(dto PolkadotDto) (node polkadotv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service polkadtoService) Create(ctx context.Context, dto PolkadotDto) (node polkadotv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = polkadotv1alpha1.NodeSpec{
 		Network: dto.Network,
@@ -65,12 +65,12 @@ func (service polkadtoService) Create(dto PolkadotDto) (node polkadotv1alpha1.No
 		node.Default()
 	}
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %s is already exits", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -79,7 +79,11 @@ func (service polkadtoService) Create(dto PolkadotDto) (node polkadotv1alpha1.No
 }
 
 // Update updates polkadot node by name from spec
-func (service polkadtoService) Update(dto PolkadotDto, node *polkadotv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service polkadtoService) Update(ctx context.Context, dto PolkadotDto, node *polkadotv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.NodePrivateKeySecretName != nil {
 		node.Spec.NodePrivateKeySecretName = *dto.NodePrivateKeySecretName
 	}
@@ -178,25 +182,25 @@ func (service polkadtoService) Update(dto PolkadotDto, node *polkadotv1alpha1.No
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -205,9 +209,9 @@ func (service polkadtoService) Update(dto PolkadotDto, node *polkadotv1alpha1.No
 }
 
 // List returns all polkadot nodes
-func (service polkadtoService) List(namespace string) (list polkadotv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	if err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.List, err)
+func (service polkadtoService) List(ctx context.Context, namespace string) (list polkadotv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -216,10 +220,10 @@ func (service polkadtoService) List(namespace string) (list polkadotv1alpha1.Nod
 }
 
 // Count returns total number of polkadot nodes
-func (service polkadtoService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service polkadtoService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &polkadotv1alpha1.NodeList{}
-	if err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace)); err != nil {
-		go logger.Error(service.Count, err)
+	if err := k8sClient.List(ctx, nodes, client.InNamespace(namespace)); err != nil {
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to count all nodes")
 		return
 	}
@@ -228,9 +232,9 @@ func (service polkadtoService) Count(namespace string) (count int, restErr restE
 }
 
 // Delete deletes polkadot node by name
-func (service polkadtoService) Delete(node *polkadotv1alpha1.Node) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), node); err != nil {
-		go logger.Error(service.Delete, err)
+func (service polkadtoService) Delete(ctx context.Context, node *polkadotv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delte node by name %s", node.Name))
 		return
 	}
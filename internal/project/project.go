@@ -0,0 +1,46 @@
+package project
+
+import (
+	"github.com/kotalco/community-api/internal/models"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ProjectDto is a group of resources of any protocol sharing the project annotation
+type ProjectDto struct {
+	models.Time
+	k8s.MetaDataDto
+	Description string      `json:"description"`
+	Members     []MemberDto `json:"members,omitempty"`
+}
+
+type ProjectListDto []ProjectDto
+
+// MemberDto is a resource of a project and its state
+// Protocol and Resource match the resource url groups, secrets are core secrets
+type MemberDto struct {
+	Protocol string `json:"protocol"`
+	Resource string `json:"resource"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	State    string `json:"state,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Link     string `json:"link"`
+}
+
+func (dto ProjectDto) FromConfigMap(configMap corev1.ConfigMap) ProjectDto {
+	dto.FromObjectMeta(configMap.ObjectMeta)
+	dto.Name = configMap.Labels[ProjectLabel]
+	dto.Time = models.Time{CreatedAt: configMap.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
+	dto.Description = configMap.Data[descriptionKey]
+	return dto
+}
+
+func (projects ProjectListDto) FromConfigMap(configMaps []corev1.ConfigMap) ProjectListDto {
+	result := make(ProjectListDto, len(configMaps))
+	for index, value := range configMaps {
+		result[index] = ProjectDto{}.FromConfigMap(value)
+	}
+	return result
+}
@@ -0,0 +1,314 @@
+// Package project internal is the domain layer for projects
+// a project is a configmap, its members are the resources of any protocol annotated with the project name
+package project
+
+import (
+	"context"
+	"fmt"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/k8s/statefulset"
+	"github.com/kotalco/community-api/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ProjectLabel is the label holding the project name of the project configmap
+	ProjectLabel = "kotal.io/project"
+	// ProjectAnnotation is the annotation holding the project name of a member, members aren't labeled
+	// because the kotal operator copies labels into the selector of their statefulset which can't be changed
+	ProjectAnnotation = "kotal.io/project"
+	// configMapPrefix prefixes the project configmap name so it doesn't use the name of a resource
+	configMapPrefix = "kotal-project-"
+	componentLabel  = "app.kubernetes.io/component"
+	component       = "project"
+	descriptionKey  = "description"
+)
+
+// secretsKind is the member kind of secrets, the only core kind that can be a member
+var secretsKind = k8s.Kind{
+	Protocol:         "core",
+	Resource:         "secrets",
+	GroupVersionKind: corev1.SchemeGroupVersion.WithKind("Secret"),
+	NewObject:        func() client.Object { return &corev1.Secret{} },
+	NewList:          func() client.ObjectList { return &corev1.SecretList{} },
+}
+
+type projectService struct{}
+
+type IService interface {
+	Get(ctx context.Context, name types.NamespacedName) (corev1.ConfigMap, restErrors.IRestErr)
+	Create(context.Context, ProjectDto) (corev1.ConfigMap, restErrors.IRestErr)
+	List(ctx context.Context, namespace string) (corev1.ConfigMapList, restErrors.IRestErr)
+	Delete(ctx context.Context, project *corev1.ConfigMap, cascade bool) restErrors.IRestErr
+	Members(ctx context.Context, project *corev1.ConfigMap) ([]MemberDto, restErrors.IRestErr)
+	Attach(ctx context.Context, project *corev1.ConfigMap, member MemberDto) (MemberDto, restErrors.IRestErr)
+	Detach(ctx context.Context, project *corev1.ConfigMap, member MemberDto) restErrors.IRestErr
+}
+
+var (
+	k8sClient          = k8s.NewClientService()
+	statefulSetService = statefulset.NewService()
+)
+
+func NewProjectService() IService {
+	return projectService{}
+}
+
+// Get returns the configmap of a project by name
+func (service projectService) Get(ctx context.Context, namespacedName types.NamespacedName) (project corev1.ConfigMap, restErr restErrors.IRestErr) {
+	key := types.NamespacedName{Namespace: namespacedName.Namespace, Name: configMapPrefix + namespacedName.Name}
+	if err := k8sClient.Get(ctx, key, &project); err != nil {
+		if apiErrors.IsNotFound(err) {
+			restErr = restErrors.NewNotFoundError(fmt.Sprintf("project by name %s doesn't exist", namespacedName.Name))
+			return
+		}
+		logger.ErrorContext(ctx, service.Get, err)
+		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get project by name %s", namespacedName.Name))
+		return
+	}
+	if project.Labels[componentLabel] != component {
+		restErr = restErrors.NewNotFoundError(fmt.Sprintf("project by name %s doesn't exist", namespacedName.Name))
+	}
+	return
+}
+
+// Create creates the configmap of a project
+func (service projectService) Create(ctx context.Context, dto ProjectDto) (project corev1.ConfigMap, restErr restErrors.IRestErr) {
+	project.ObjectMeta = dto.ObjectMetaFromMetadataDto()
+	project.Name = configMapPrefix + dto.Name
+	if project.Labels == nil {
+		project.Labels = map[string]string{}
+	}
+	project.Labels[ProjectLabel] = dto.Name
+	project.Labels[componentLabel] = component
+	project.Labels["app.kubernetes.io/created-by"] = "kotal-api"
+	project.Data = map[string]string{descriptionKey: dto.Description}
+
+	if err := k8sClient.Create(ctx, &project); err != nil {
+		if apiErrors.IsAlreadyExists(err) {
+			restErr = restErrors.NewConflictError(fmt.Sprintf("project by name %s already exist", dto.Name))
+			return
+		}
+		logger.ErrorContext(ctx, service.Create, err)
+		restErr = restErrors.NewInternalServerError("error creating project")
+		return
+	}
+	return
+}
+
+// List returns the configmaps of all projects
+func (service projectService) List(ctx context.Context, namespace string) (list corev1.ConfigMapList, restErr restErrors.IRestErr) {
+	if err := k8sClient.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabels{componentLabel: component}); err != nil {
+		logger.ErrorContext(ctx, service.List, err)
+		restErr = restErrors.NewInternalServerError("failed to get all projects")
+		return
+	}
+	return
+}
+
+// Delete deletes a project, its members are deleted if cascade is true and detached otherwise
+// the project is kept if a member can't be deleted or detached so the request can be retried
+func (service projectService) Delete(ctx context.Context, project *corev1.ConfigMap, cascade bool) restErrors.IRestErr {
+	members, restErr := service.members(ctx, project)
+	if restErr != nil {
+		return restErr
+	}
+
+	for _, member := range members {
+		var err error
+		if cascade {
+			err = k8sClient.Delete(ctx, member.Object)
+		} else {
+			err = service.unannotate(ctx, member.Object)
+		}
+		if err != nil && !apiErrors.IsNotFound(err) {
+			logger.ErrorContext(ctx, service.Delete, err)
+			return restErrors.NewInternalServerError(fmt.Sprintf("can't delete project by name %s, failed to remove member %s", project.Labels[ProjectLabel], member.GetName()))
+		}
+	}
+
+	if err := k8sClient.Delete(ctx, project); err != nil && !apiErrors.IsNotFound(err) {
+		logger.ErrorContext(ctx, service.Delete, err)
+		return restErrors.NewInternalServerError(fmt.Sprintf("can't delete project by name %s", project.Labels[ProjectLabel]))
+	}
+	return nil
+}
+
+// Members returns the members of a project with their state computed from their statefulset and pod
+func (service projectService) Members(ctx context.Context, project *corev1.ConfigMap) ([]MemberDto, restErrors.IRestErr) {
+	objects, restErr := service.members(ctx, project)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	statuses, restErr := statefulSetService.Statuses(ctx, project.Namespace)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	members := make([]MemberDto, 0, len(objects))
+	for _, object := range objects {
+		member := newMember(object.kind, object.GetName())
+		if object.kind.Protocol != secretsKind.Protocol {
+			status := statuses.Of(object.GetName())
+			member.State, member.Reason = status.Phase, status.Reason
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// Attach annotates a resource with the project name, only its annotations are patched
+// a resource can be a member of a single project
+func (service projectService) Attach(ctx context.Context, project *corev1.ConfigMap, member MemberDto) (MemberDto, restErrors.IRestErr) {
+	kind, obj, restErr := service.getMember(ctx, project.Namespace, member)
+	if restErr != nil {
+		return MemberDto{}, restErr
+	}
+
+	name := project.Labels[ProjectLabel]
+	if current, ok := obj.GetAnnotations()[ProjectAnnotation]; ok && current != name {
+		return MemberDto{}, restErrors.NewConflictError(fmt.Sprintf("%s %s is a member of project %s", kind.Kind, member.Name, current))
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ProjectAnnotation] = name
+	obj.SetAnnotations(annotations)
+	if err := k8sClient.Patch(ctx, obj, patch); err != nil {
+		logger.ErrorContext(ctx, service.Attach, err)
+		return MemberDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't attach %s %s to project %s", kind.Kind, member.Name, name))
+	}
+
+	return newMember(kind, obj.GetName()), nil
+}
+
+// Detach removes the project annotation of a member
+func (service projectService) Detach(ctx context.Context, project *corev1.ConfigMap, member MemberDto) restErrors.IRestErr {
+	kind, obj, restErr := service.getMember(ctx, project.Namespace, member)
+	if restErr != nil {
+		return restErr
+	}
+
+	name := project.Labels[ProjectLabel]
+	if !IsMember(obj, name) {
+		return restErrors.NewNotFoundError(fmt.Sprintf("%s %s isn't a member of project %s", kind.Kind, member.Name, name))
+	}
+
+	if err := service.unannotate(ctx, obj); err != nil {
+		logger.ErrorContext(ctx, service.Detach, err)
+		return restErrors.NewInternalServerError(fmt.Sprintf("can't detach %s %s from project %s", kind.Kind, member.Name, name))
+	}
+	return nil
+}
+
+// memberObject is a member object and its kind
+type memberObject struct {
+	client.Object
+	kind k8s.Kind
+}
+
+// members returns the member objects of a project, annotations can't be selected so resources are listed then filtered
+func (service projectService) members(ctx context.Context, project *corev1.ConfigMap) ([]memberObject, restErrors.IRestErr) {
+	name := project.Labels[ProjectLabel]
+	kinds, err := k8s.ListKotalKinds(ctx, project.Namespace)
+	if err != nil {
+		logger.ErrorContext(ctx, service.members, err)
+		return nil, restErrors.NewInternalServerError("can't list project members")
+	}
+	secrets := corev1.SecretList{}
+	if err = k8sClient.List(ctx, &secrets, client.InNamespace(project.Namespace)); err != nil {
+		logger.ErrorContext(ctx, service.members, err)
+		return nil, restErrors.NewInternalServerError("can't list project members")
+	}
+
+	objects := make([]memberObject, 0)
+	for _, kind := range Members(kinds, name) {
+		for _, obj := range kind.Objects {
+			objects = append(objects, memberObject{Object: obj, kind: kind.Kind})
+		}
+	}
+	for i := range secrets.Items {
+		if IsMember(&secrets.Items[i], name) {
+			objects = append(objects, memberObject{Object: &secrets.Items[i], kind: secretsKind})
+		}
+	}
+	return objects, nil
+}
+
+// Members returns the objects of kinds that are members of the project by name
+func Members(kinds []k8s.KindObjects, name string) []k8s.KindObjects {
+	result := make([]k8s.KindObjects, len(kinds))
+	for i, kind := range kinds {
+		result[i] = k8s.KindObjects{Kind: kind.Kind, Objects: make([]client.Object, 0)}
+		for _, obj := range kind.Objects {
+			if IsMember(obj, name) {
+				result[i].Objects = append(result[i].Objects, obj)
+			}
+		}
+	}
+	return result
+}
+
+// IsMember reports whether obj is a member of the project by name
+func IsMember(obj client.Object, name string) bool {
+	return obj.GetAnnotations()[ProjectAnnotation] == name
+}
+
+// getMember returns the kind and the object of a member by protocol, resource and name
+func (service projectService) getMember(ctx context.Context, namespace string, member MemberDto) (k8s.Kind, client.Object, restErrors.IRestErr) {
+	kind, ok := memberKind(member.Protocol, member.Resource)
+	if !ok {
+		return k8s.Kind{}, nil, restErrors.NewBadRequestError(fmt.Sprintf("unknown resource %s/%s", member.Protocol, member.Resource))
+	}
+
+	obj := kind.NewObject()
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: member.Name}, obj); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return k8s.Kind{}, nil, restErrors.NewNotFoundError(fmt.Sprintf("%s by name %s doesn't exist", kind.Kind, member.Name))
+		}
+		logger.ErrorContext(ctx, service.getMember, err)
+		return k8s.Kind{}, nil, restErrors.NewInternalServerError(fmt.Sprintf("can't get %s by name %s", kind.Kind, member.Name))
+	}
+	return kind, obj, nil
+}
+
+// unannotate patches the project annotation out of the annotations of obj, the rest of the object isn't written so concurrent changes don't conflict
+func (service projectService) unannotate(ctx context.Context, obj client.Object) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	delete(annotations, ProjectAnnotation)
+	obj.SetAnnotations(annotations)
+	return k8sClient.Patch(ctx, obj, patch)
+}
+
+// memberKind returns the kind of a member from its protocol and resource url groups
+func memberKind(protocol, resource string) (k8s.Kind, bool) {
+	if protocol == secretsKind.Protocol && resource == secretsKind.Resource {
+		return secretsKind, true
+	}
+	for _, kind := range k8s.KotalKinds {
+		if kind.Protocol == protocol && kind.Resource == resource && kind.IsServed() {
+			return kind, true
+		}
+	}
+	return k8s.Kind{}, false
+}
+
+func newMember(kind k8s.Kind, name string) MemberDto {
+	return MemberDto{
+		Protocol: kind.Protocol,
+		Resource: kind.Resource,
+		Kind:     kind.Kind,
+		Name:     name,
+		Link:     fmt.Sprintf("/api/v1/%s/%s/%s", kind.Protocol, kind.Resource, name),
+	}
+}
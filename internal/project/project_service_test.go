@@ -0,0 +1,94 @@
+package project
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kotalco/community-api/pkg/k8s"
+	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, k8s.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	k8sClient = fakeClient
+	return fakeClient
+}
+
+func newProject(name string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      configMapPrefix + name,
+		Namespace: "default",
+		Labels:    map[string]string{ProjectLabel: name, componentLabel: component},
+	}}
+}
+
+func newPeer(name string, annotations map[string]string) *ipfsv1alpha1.Peer {
+	return &ipfsv1alpha1.Peer{ObjectMeta: metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   "default",
+		Labels:      map[string]string{"team": "core"},
+		Annotations: annotations,
+	}}
+}
+
+func getPeer(t *testing.T, c client.Client, name string) *ipfsv1alpha1.Peer {
+	peer := &ipfsv1alpha1.Peer{}
+	assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: name}, peer))
+	return peer
+}
+
+func TestAttach(t *testing.T) {
+	ctx := context.Background()
+	member := MemberDto{Protocol: "ipfs", Resource: "peers", Name: "peer-1"}
+
+	// the kotal operator copies the labels of a resource into the immutable selector of its statefulset
+	t.Run("members are annotated, their labels aren't changed", func(t *testing.T) {
+		fakeClient := newClient(t, newPeer("peer-1", nil))
+		_, err := NewProjectService().Attach(ctx, newProject("staking"), member)
+		assert.Nil(t, err)
+		peer := getPeer(t, fakeClient, "peer-1")
+		assert.Equal(t, "staking", peer.Annotations[ProjectAnnotation])
+		assert.Equal(t, map[string]string{"team": "core"}, peer.Labels)
+
+		assert.Nil(t, NewProjectService().Detach(ctx, newProject("staking"), member))
+		peer = getPeer(t, fakeClient, "peer-1")
+		assert.NotContains(t, peer.Annotations, ProjectAnnotation)
+		assert.Equal(t, map[string]string{"team": "core"}, peer.Labels)
+	})
+
+	t.Run("member of another project", func(t *testing.T) {
+		newClient(t, newPeer("peer-1", map[string]string{ProjectAnnotation: "archive"}))
+		_, err := NewProjectService().Attach(ctx, newProject("staking"), member)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusConflict, err.StatusCode())
+
+		err = NewProjectService().Detach(ctx, newProject("staking"), member)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode())
+	})
+}
+
+func TestMembers(t *testing.T) {
+	kind, _ := k8s.KindFor(ipfsv1alpha1.GroupVersion.WithKind("Peer"))
+	kinds := []k8s.KindObjects{{Kind: kind, Objects: []client.Object{
+		newPeer("peer-1", map[string]string{ProjectAnnotation: "staking"}),
+		newPeer("peer-2", nil),
+		newPeer("peer-3", map[string]string{ProjectAnnotation: "archive"}),
+	}}}
+
+	members := Members(kinds, "staking")
+	assert.Len(t, members, 1)
+	assert.Equal(t, kind.GroupVersionKind, members[0].Kind.GroupVersionKind)
+	assert.Len(t, members[0].Objects, 1)
+	assert.Equal(t, "peer-1", members[0].Objects[0].GetName())
+}
@@ -0,0 +1,16 @@
+package search
+
+// HitDto is a resource matching a search query
+type HitDto struct {
+	Protocol   string   `json:"protocol"`
+	Resource   string   `json:"resource"`
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Name       string   `json:"name"`
+	Network    string   `json:"network,omitempty"`
+	Client     string   `json:"client,omitempty"`
+	Image      string   `json:"image,omitempty"`
+	Link       string   `json:"link"`
+	Score      int      `json:"score"`
+	Matches    []string `json:"matches"`
+}
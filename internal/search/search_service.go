@@ -0,0 +1,141 @@
+// Package search internal is the domain layer for searching resources
+// searches every kotal kind in the namespace and ranks the resources matching the query
+package search
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// scores of the matched fields, the score of a hit is the sum of the scores of its matched fields
+const (
+	exactNameScore  = 100
+	namePrefixScore = 60
+	nameScore       = 40
+	labelScore      = 20
+	networkScore    = 15
+	clientScore     = 15
+	imageScore      = 10
+)
+
+type searchService struct{}
+
+type IService interface {
+	Search(ctx context.Context, namespace string, query string) ([]HitDto, restErrors.IRestErr)
+}
+
+func NewSearchService() IService {
+	return searchService{}
+}
+
+// Search returns the resources of every kotal kind in namespace whose name, labels, network, client or image contain query
+// hits are sorted by descending score then by name
+func (service searchService) Search(ctx context.Context, namespace string, query string) ([]HitDto, restErrors.IRestErr) {
+	kinds, err := k8s.ListKotalKinds(ctx, namespace)
+	if err != nil {
+		logger.ErrorContext(ctx, service.Search, err)
+		return nil, restErrors.NewInternalServerError("can't search resources")
+	}
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	hits := make([]HitDto, 0)
+	for _, kind := range kinds {
+		for _, obj := range kind.Objects {
+			if hit, ok := match(kind.Kind, obj, query); ok {
+				hits = append(hits, hit)
+			}
+		}
+	}
+
+	rank(hits)
+	return hits, nil
+}
+
+// rank sorts hits by descending score then by name
+func rank(hits []HitDto) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Name < hits[j].Name
+	})
+}
+
+// match scores obj against the lower case query
+func match(kind k8s.Kind, obj client.Object, query string) (HitDto, bool) {
+	hit := HitDto{
+		Protocol:   kind.Protocol,
+		Resource:   kind.Resource,
+		APIVersion: kind.GroupVersion().String(),
+		Kind:       kind.Kind,
+		Name:       obj.GetName(),
+		Link:       fmt.Sprintf("/api/v1/%s/%s/%s", kind.Protocol, kind.Resource, obj.GetName()),
+		Matches:    []string{},
+	}
+
+	// network, client and image aren't common fields of the kotal kinds, they're read from the unstructured object
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err == nil {
+		hit.Network = nestedString(content, "spec", "network")
+		hit.Client = nestedString(content, "spec", "client")
+		if hit.Client == "" {
+			hit.Client = nestedString(content, "status", "client")
+		}
+		hit.Image = nestedString(content, "spec", "image")
+	}
+
+	name := strings.ToLower(hit.Name)
+	switch {
+	case name == query:
+		hit.Score += exactNameScore
+	case strings.HasPrefix(name, query):
+		hit.Score += namePrefixScore
+	case strings.Contains(name, query):
+		hit.Score += nameScore
+	}
+	if hit.Score > 0 {
+		hit.Matches = append(hit.Matches, "name")
+	}
+
+	for key, value := range obj.GetLabels() {
+		if strings.Contains(strings.ToLower(key), query) || strings.Contains(strings.ToLower(value), query) {
+			hit.Score += labelScore
+			hit.Matches = append(hit.Matches, "labels")
+			break
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		score int
+	}{
+		{"network", hit.Network, networkScore},
+		{"client", hit.Client, clientScore},
+		{"image", hit.Image, imageScore},
+	} {
+		if field.value != "" && strings.Contains(strings.ToLower(field.value), query) {
+			hit.Score += field.score
+			hit.Matches = append(hit.Matches, field.name)
+		}
+	}
+
+	return hit, hit.Score > 0
+}
+
+func nestedString(content map[string]interface{}, fields ...string) string {
+	value, _, _ := unstructured.NestedFieldNoCopy(content, fields...)
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/kotalco/community-api/pkg/k8s"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func node(name string, labels map[string]string) *ethereumv1alpha1.Node {
+	return &ethereumv1alpha1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: ethereumv1alpha1.NodeSpec{
+			Network: ethereumv1alpha1.GoerliNetwork,
+			Client:  ethereumv1alpha1.GethClient,
+			Image:   "kotalco/geth:v1.11.6",
+		},
+	}
+}
+
+func TestMatch(t *testing.T) {
+	kind, _ := k8s.KindFor(ethereumv1alpha1.GroupVersion.WithKind("Node"))
+
+	tests := []struct {
+		name    string
+		node    *ethereumv1alpha1.Node
+		query   string
+		ok      bool
+		score   int
+		matches []string
+	}{
+		{"exact name", node("validator", nil), "validator", true, exactNameScore, []string{"name"}},
+		{"name prefix", node("validator-1", nil), "validator", true, namePrefixScore, []string{"name"}},
+		{"name substring", node("my-validator", nil), "validator", true, nameScore, []string{"name"}},
+		{"upper case name", node("My-Validator", nil), "validator", true, nameScore, []string{"name"}},
+		{"label value", node("node-1", map[string]string{"team": "validator"}), "validator", true, labelScore, []string{"labels"}},
+		{"label key", node("node-1", map[string]string{"validator": "true"}), "validator", true, labelScore, []string{"labels"}},
+		{"labels scored once", node("node-1", map[string]string{"validator": "validator", "role": "validator"}), "validator", true, labelScore, []string{"labels"}},
+		{"name and label", node("validator-1", map[string]string{"team": "validator"}), "validator", true, namePrefixScore + labelScore, []string{"name", "labels"}},
+		{"network", node("node-1", nil), "goerli", true, networkScore, []string{"network"}},
+		{"client and image", node("node-1", nil), "geth", true, clientScore + imageScore, []string{"client", "image"}},
+		{"no match", node("node-1", map[string]string{"team": "infra"}), "validator", false, 0, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hit, ok := match(kind, test.node, test.query)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.score, hit.Score)
+			assert.Equal(t, test.matches, hit.Matches)
+		})
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		hit, _ := match(kind, node("validator", nil), "validator")
+		assert.Equal(t, "ethereum", hit.Protocol)
+		assert.Equal(t, "nodes", hit.Resource)
+		assert.Equal(t, "ethereum.kotal.io/v1alpha1", hit.APIVersion)
+		assert.Equal(t, "Node", hit.Kind)
+		assert.Equal(t, ethereumv1alpha1.GoerliNetwork, hit.Network)
+		assert.Equal(t, string(ethereumv1alpha1.GethClient), hit.Client)
+		assert.Equal(t, "kotalco/geth:v1.11.6", hit.Image)
+		assert.Equal(t, "/api/v1/ethereum/nodes/validator", hit.Link)
+	})
+}
+
+func TestRank(t *testing.T) {
+	kind, _ := k8s.KindFor(ethereumv1alpha1.GroupVersion.WithKind("Node"))
+	nodes := []*ethereumv1alpha1.Node{
+		node("node-2", map[string]string{"team": "validator"}),
+		node("my-validator", nil),
+		node("node-1", map[string]string{"team": "validator"}),
+		node("validator-1", nil),
+		node("validator", nil),
+		node("validator-0", map[string]string{"team": "validator"}),
+	}
+
+	hits := make([]HitDto, 0)
+	for _, n := range nodes {
+		if hit, ok := match(kind, n, "validator"); ok {
+			hits = append(hits, hit)
+		}
+	}
+	rank(hits)
+
+	names := make([]string, len(hits))
+	for i, hit := range hits {
+		names[i] = hit.Name
+	}
+	// exact name, name prefix with a label, name prefix, name substring, then labels only ordered by name
+	assert.Equal(t, []string{"validator", "validator-0", "validator-1", "my-validator", "node-1", "node-2"}, names)
+}
@@ -10,6 +10,7 @@ import (
 
 type StacksDto struct {
 	models.Time
+	models.State
 	k8s.MetaDataDto
 	Image                    string                       `json:"image"`
 	Network                  stacksv1alpha1.StacksNetwork `json:"network"`
@@ -27,7 +28,7 @@ type StacksDto struct {
 type StacksListDto []StacksDto
 
 func (dto StacksDto) FromStacksNode(n stacksv1alpha1.Node) StacksDto {
-	dto.Name = n.Name
+	dto.FromObjectMeta(n.ObjectMeta)
 	dto.Time = models.Time{CreatedAt: n.CreationTimestamp.UTC().Format(shared.JavascriptISOString)}
 	dto.Image = n.Spec.Image
 	dto.Network = n.Spec.Network
@@ -16,12 +16,12 @@ import (
 type stacksService struct{}
 
 type IService interface {
-	Get(types.NamespacedName) (stacksv1alpha1.Node, restErrors.IRestErr)
-	Create(StacksDto) (stacksv1alpha1.Node, restErrors.IRestErr)
-	List(namespace string) (stacksv1alpha1.NodeList, restErrors.IRestErr)
-	Count(namespace string) (int, restErrors.IRestErr)
-	Delete(*stacksv1alpha1.Node) restErrors.IRestErr
-	Update(StacksDto, *stacksv1alpha1.Node) restErrors.IRestErr
+	Get(context.Context, types.NamespacedName) (stacksv1alpha1.Node, restErrors.IRestErr)
+	Create(context.Context, StacksDto) (stacksv1alpha1.Node, restErrors.IRestErr)
+	List(ctx context.Context, namespace string) (stacksv1alpha1.NodeList, restErrors.IRestErr)
+	Count(ctx context.Context, namespace string) (int, restErrors.IRestErr)
+	Delete(context.Context, *stacksv1alpha1.Node) restErrors.IRestErr
+	Update(context.Context, StacksDto, *stacksv1alpha1.Node) restErrors.IRestErr
 }
 
 var (
@@ -33,7 +33,7 @@ func NewStacksService() IService {
 }
 
 // Create creates stacks node from spec
-func (service stacksService) Create(dto StacksDto) (node stacksv1alpha1.Node, restErr restErrors.IRestErr) {
+func (service stacksService) Create(ctx context.Context, dto StacksDto) (node stacksv1alpha1.Node, restErr restErrors.IRestErr) {
 	node.ObjectMeta = dto.ObjectMetaFromMetadataDto()
 	node.Spec = stacksv1alpha1.NodeSpec{
 		Network:     dto.Network,
@@ -44,12 +44,12 @@ func (service stacksService) Create(dto StacksDto) (node stacksv1alpha1.Node, re
 
 	k8s.DefaultResources(&node.Spec.Resources)
 
-	if err := k8sClient.Create(context.Background(), &node); err != nil {
+	if err := k8sClient.Create(ctx, &node); err != nil {
 		if apiErrors.IsAlreadyExists(err) {
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("node by name %s is already exits", node.Name))
 			return
 		}
-		go logger.Error(service.Create, err)
+		logger.ErrorContext(ctx, service.Create, err)
 		restErr = restErrors.NewInternalServerError("failed to create node")
 		return
 	}
@@ -58,13 +58,13 @@ func (service stacksService) Create(dto StacksDto) (node stacksv1alpha1.Node, re
 }
 
 // Get returns a single stacks node by name
-func (service stacksService) Get(namespacedName types.NamespacedName) (node stacksv1alpha1.Node, restErr restErrors.IRestErr) {
-	if err := k8sClient.Get(context.Background(), namespacedName, &node); err != nil {
+func (service stacksService) Get(ctx context.Context, namespacedName types.NamespacedName) (node stacksv1alpha1.Node, restErr restErrors.IRestErr) {
+	if err := k8sClient.Get(ctx, namespacedName, &node); err != nil {
 		if apiErrors.IsNotFound(err) {
 			restErr = restErrors.NewNotFoundError(fmt.Sprintf("node by name %s doesn't exist", namespacedName.Name))
 			return
 		}
-		go logger.Error(service.Get, err)
+		logger.ErrorContext(ctx, service.Get, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't get node by name %s", namespacedName.Name))
 		return
 	}
@@ -73,10 +73,10 @@ func (service stacksService) Get(namespacedName types.NamespacedName) (node stac
 }
 
 // List returns all stacks nodes
-func (service stacksService) List(namespace string) (list stacksv1alpha1.NodeList, restErr restErrors.IRestErr) {
-	err := k8sClient.List(context.Background(), &list, client.InNamespace(namespace))
+func (service stacksService) List(ctx context.Context, namespace string) (list stacksv1alpha1.NodeList, restErr restErrors.IRestErr) {
+	err := k8sClient.List(ctx, &list, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.List, err)
+		logger.ErrorContext(ctx, service.List, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -85,11 +85,11 @@ func (service stacksService) List(namespace string) (list stacksv1alpha1.NodeLis
 }
 
 // Count returns all nodes length
-func (service stacksService) Count(namespace string) (count int, restErr restErrors.IRestErr) {
+func (service stacksService) Count(ctx context.Context, namespace string) (count int, restErr restErrors.IRestErr) {
 	nodes := &stacksv1alpha1.NodeList{}
-	err := k8sClient.List(context.Background(), nodes, client.InNamespace(namespace))
+	err := k8sClient.List(ctx, nodes, client.InNamespace(namespace))
 	if err != nil {
-		go logger.Error(service.Count, err)
+		logger.ErrorContext(ctx, service.Count, err)
 		restErr = restErrors.NewInternalServerError("failed to get all nodes")
 		return
 	}
@@ -98,7 +98,11 @@ func (service stacksService) Count(namespace string) (count int, restErr restErr
 }
 
 // Update updates a single node by name from spec
-func (service stacksService) Update(dto StacksDto, node *stacksv1alpha1.Node) (restErr restErrors.IRestErr) {
+func (service stacksService) Update(ctx context.Context, dto StacksDto, node *stacksv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if restErr = dto.UpdateObjectMeta(&node.ObjectMeta); restErr != nil {
+		return
+	}
+
 	if dto.Image != "" {
 		node.Spec.Image = dto.Image
 	}
@@ -165,25 +169,25 @@ func (service stacksService) Update(dto StacksDto, node *stacksv1alpha1.Node) (r
 			Namespace: node.Namespace,
 			Name:      fmt.Sprintf("%s-0", node.Name),
 		}
-		err := k8sClient.Get(context.Background(), key, pod)
+		err := k8sClient.Get(ctx, key, pod)
 		if apiErrors.IsNotFound(err) {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewBadRequestError(fmt.Sprintf("pod by name %s doesn't exit", key.Name))
 			return
 		}
 		podIsPending = pod.Status.Phase == corev1.PodPending
 	}
 
-	if err := k8sClient.Update(context.Background(), node); err != nil {
-		go logger.Error(service.Update, err)
+	if err := k8sClient.Update(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Update, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 		return
 	}
 
 	if podIsPending {
-		err := k8sClient.Delete(context.Background(), pod)
+		err := k8sClient.Delete(ctx, pod)
 		if err != nil {
-			go logger.Error(service.Update, err)
+			logger.ErrorContext(ctx, service.Update, err)
 			restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't update node by name %s", node.Name))
 			return
 		}
@@ -193,9 +197,9 @@ func (service stacksService) Update(dto StacksDto, node *stacksv1alpha1.Node) (r
 }
 
 // Delete deletes stacks node by name
-func (service stacksService) Delete(node *stacksv1alpha1.Node) (restErr restErrors.IRestErr) {
-	if err := k8sClient.Delete(context.Background(), node); err != nil {
-		go logger.Error(service.Delete, err)
+func (service stacksService) Delete(ctx context.Context, node *stacksv1alpha1.Node) (restErr restErrors.IRestErr) {
+	if err := k8sClient.Delete(ctx, node); err != nil {
+		logger.ErrorContext(ctx, service.Delete, err)
 		restErr = restErrors.NewInternalServerError(fmt.Sprintf("can't delete node by name %s", node.Name))
 		return
 	}
@@ -0,0 +1,343 @@
+package template
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/kotalco/community-api/pkg/k8s"
+	bitcoinv1alpha1 "github.com/kotalco/kotal/apis/bitcoin/v1alpha1"
+	chainlinkv1alpha1 "github.com/kotalco/kotal/apis/chainlink/v1alpha1"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
+	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	stacksv1alpha1 "github.com/kotalco/kotal/apis/stacks/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// StackLabel and TemplateLabel are set on every resource created by a template
+	StackLabel    = "kotal.io/stack"
+	TemplateLabel = "kotal.io/template"
+)
+
+type parameter = ParameterDto
+
+// blueprint builds the resources of a template in creation order, referenced resources first
+type blueprint struct {
+	name        string
+	description string
+	parameters  []parameter
+	build       func(s stack) ([]client.Object, error)
+}
+
+// stack is a template instance with its parameters resolved
+type stack struct {
+	name       string
+	namespace  string
+	template   string
+	parameters map[string]string
+}
+
+// blueprints is the catalog of built-in templates
+var blueprints = []blueprint{
+	{
+		name:        "ethereum-full-stack",
+		description: "Post-merge Ethereum node: a JWT secret, an execution client with the engine api, a beacon node connected to it and an optional validator",
+		parameters: []parameter{
+			{Name: "network", Description: "Ethereum network", Default: ethereumv1alpha1.MainNetwork, Values: []string{ethereumv1alpha1.MainNetwork, ethereumv1alpha1.GoerliNetwork, ethereumv1alpha1.SepoliaNetwork}},
+			{Name: "executionClient", Description: "execution client", Default: string(ethereumv1alpha1.GethClient), Values: []string{string(ethereumv1alpha1.BesuClient), string(ethereumv1alpha1.GethClient), string(ethereumv1alpha1.NethermindClient)}},
+			{Name: "consensusClient", Description: "beacon node and validator client", Default: string(ethereum2v1alpha1.LighthouseClient), Values: []string{string(ethereum2v1alpha1.LighthouseClient), string(ethereum2v1alpha1.NimbusClient), string(ethereum2v1alpha1.PrysmClient), string(ethereum2v1alpha1.TekuClient)}},
+			{Name: "checkpointSyncUrl", Description: "beacon node checkpoint sync url"},
+			{Name: "feeRecipient", Description: "address receiving the transaction fees"},
+			{Name: "validatorKeystores", Description: "comma separated names of validator keystore secrets, the validator is created only if it's set"},
+			{Name: "walletPasswordSecret", Description: "name of the validator wallet password secret, required by prysm validators"},
+		},
+		build: buildEthereumFullStack,
+	},
+	{
+		name:        "ipfs-cluster",
+		description: "IPFS peer and an IPFS cluster peer managing its pins with a generated cluster secret",
+		parameters:  []parameter{},
+		build:       buildIPFSCluster,
+	},
+	{
+		name:        "stacks-bitcoin",
+		description: "Bitcoin node with a generated rpc user and a Stacks node connected to it",
+		parameters: []parameter{
+			{Name: "network", Description: "Stacks and Bitcoin network", Default: string(stacksv1alpha1.Mainnet), Values: []string{string(stacksv1alpha1.Mainnet), string(stacksv1alpha1.Testnet)}},
+			{Name: "rpcUsername", Description: "Bitcoin rpc user of the Stacks node", Default: "stacks"},
+		},
+		build: buildStacksBitcoin,
+	},
+	{
+		name:        "chainlink-ethereum",
+		description: "Ethereum execution client and a Chainlink node connected to its rpc and websocket endpoints",
+		parameters: []parameter{
+			{Name: "network", Description: "Ethereum network", Default: ethereumv1alpha1.MainNetwork, Values: []string{ethereumv1alpha1.MainNetwork, ethereumv1alpha1.GoerliNetwork, ethereumv1alpha1.SepoliaNetwork}},
+			{Name: "executionClient", Description: "execution client", Default: string(ethereumv1alpha1.GethClient), Values: []string{string(ethereumv1alpha1.BesuClient), string(ethereumv1alpha1.GethClient), string(ethereumv1alpha1.NethermindClient)}},
+			{Name: "databaseURL", Description: "Chainlink PostgreSQL database url", Required: true},
+			{Name: "apiEmail", Description: "Chainlink operator ui login email", Required: true},
+			{Name: "apiPassword", Description: "Chainlink operator ui login password", Required: true},
+			{Name: "linkContractAddress", Description: "LINK token contract address, defaults to the network one"},
+		},
+		build: buildChainlinkEthereum,
+	},
+}
+
+// chainIDs and linkContracts are the chain id and the LINK token contract of the Ethereum networks
+var (
+	chainIDs = map[string]uint{
+		ethereumv1alpha1.MainNetwork:    1,
+		ethereumv1alpha1.GoerliNetwork:  5,
+		ethereumv1alpha1.SepoliaNetwork: 11155111,
+	}
+	linkContracts = map[string]string{
+		ethereumv1alpha1.MainNetwork:    "0x514910771AF9Ca656af840dff83E8264EcF986CA",
+		ethereumv1alpha1.GoerliNetwork:  "0x326C977E6efc84E512bB9C30f76E30c160eD06FB",
+		ethereumv1alpha1.SepoliaNetwork: "0x779877A7B0D9E8603169DdbD7836e478b4624789",
+	}
+)
+
+func buildEthereumFullStack(s stack) ([]client.Object, error) {
+	jwt, err := s.secret("jwt", "jwt_secret", "secret", randomHex)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := &ethereumv1alpha1.Node{ObjectMeta: s.meta("execution")}
+	execution.Spec = ethereumv1alpha1.NodeSpec{
+		Network:       s.parameters["network"],
+		Client:        ethereumv1alpha1.EthereumClient(s.parameters["executionClient"]),
+		RPC:           true,
+		Engine:        true,
+		EnginePort:    ethereumv1alpha1.DefaultEngineRPCPort,
+		JWTSecretName: jwt.Name,
+	}
+	k8s.DefaultResources(&execution.Spec.Resources)
+
+	consensusClient := ethereum2v1alpha1.Ethereum2Client(s.parameters["consensusClient"])
+	beacon := &ethereum2v1alpha1.BeaconNode{ObjectMeta: s.meta("beacon")}
+	beacon.Spec = ethereum2v1alpha1.BeaconNodeSpec{
+		Network:                 s.parameters["network"],
+		Client:                  consensusClient,
+		ExecutionEngineEndpoint: fmt.Sprintf("http://%s:%d", execution.Name, ethereumv1alpha1.DefaultEngineRPCPort),
+		JWTSecretName:           jwt.Name,
+		CheckpointSyncURL:       s.parameters["checkpointSyncUrl"],
+		FeeRecipient:            sharedAPI.EthereumAddress(s.parameters["feeRecipient"]),
+	}
+	// prysm validators connect to the beacon node grpc server, the other clients use the rest api
+	beaconEndpoint := fmt.Sprintf("http://%s:%d", beacon.Name, ethereum2v1alpha1.DefaultRestPort)
+	if consensusClient == ethereum2v1alpha1.PrysmClient {
+		beacon.Spec.RPC = true
+		beacon.Spec.RPCPort = ethereum2v1alpha1.DefaultRPCPort
+		beaconEndpoint = fmt.Sprintf("%s:%d", beacon.Name, ethereum2v1alpha1.DefaultRPCPort)
+	} else {
+		beacon.Spec.REST = true
+		beacon.Spec.RESTPort = ethereum2v1alpha1.DefaultRestPort
+	}
+	k8s.DefaultResources(&beacon.Spec.Resources)
+
+	objects := []client.Object{jwt, execution, beacon}
+
+	if keystores := s.list("validatorKeystores"); len(keystores) > 0 {
+		validator := &ethereum2v1alpha1.Validator{ObjectMeta: s.meta("validator")}
+		validator.Spec = ethereum2v1alpha1.ValidatorSpec{
+			Network:              s.parameters["network"],
+			Client:               consensusClient,
+			BeaconEndpoints:      []string{beaconEndpoint},
+			FeeRecipient:         sharedAPI.EthereumAddress(s.parameters["feeRecipient"]),
+			WalletPasswordSecret: s.parameters["walletPasswordSecret"],
+		}
+		for _, keystore := range keystores {
+			validator.Spec.Keystores = append(validator.Spec.Keystores, ethereum2v1alpha1.Keystore{SecretName: keystore})
+		}
+		k8s.DefaultResources(&validator.Spec.Resources)
+		objects = append(objects, validator)
+	}
+
+	return objects, nil
+}
+
+func buildIPFSCluster(s stack) ([]client.Object, error) {
+	clusterSecret, err := s.secret("cluster-secret", "ipfs_cluster_secret", "secret", randomHex)
+	if err != nil {
+		return nil, err
+	}
+
+	peer := &ipfsv1alpha1.Peer{ObjectMeta: s.meta("peer")}
+	peer.Spec = ipfsv1alpha1.PeerSpec{
+		API:     true,
+		APIPort: ipfsv1alpha1.DefaultAPIPort,
+	}
+	k8s.DefaultResources(&peer.Spec.Resources)
+
+	clusterPeer := &ipfsv1alpha1.ClusterPeer{ObjectMeta: s.meta("cluster-peer")}
+	clusterPeer.Spec = ipfsv1alpha1.ClusterPeerSpec{
+		Consensus:         ipfsv1alpha1.CRDT,
+		ClusterSecretName: clusterSecret.Name,
+		PeerEndpoint:      fmt.Sprintf("/dns4/%s/tcp/%d", peer.Name, ipfsv1alpha1.DefaultAPIPort),
+	}
+	k8s.DefaultResources(&clusterPeer.Spec.Resources)
+
+	return []client.Object{clusterSecret, peer, clusterPeer}, nil
+}
+
+func buildStacksBitcoin(s stack) ([]client.Object, error) {
+	password, err := s.secret("bitcoin-rpc-password", "password", "password", randomPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	network := s.parameters["network"]
+	rpcPort, p2pPort := bitcoinv1alpha1.DefaultMainnetRPCPort, bitcoinv1alpha1.DefaultMainnetP2PPort
+	if network == string(bitcoinv1alpha1.Testnet) {
+		rpcPort, p2pPort = bitcoinv1alpha1.DefaultTestnetRPCPort, bitcoinv1alpha1.DefaultTestnetP2PPort
+	}
+
+	bitcoin := &bitcoinv1alpha1.Node{ObjectMeta: s.meta("bitcoin")}
+	bitcoin.Spec = bitcoinv1alpha1.NodeSpec{
+		Network: bitcoinv1alpha1.BitcoinNetwork(network),
+		RPC:     true,
+		RPCPort: rpcPort,
+		P2PPort: p2pPort,
+		RPCUsers: []bitcoinv1alpha1.RPCUser{
+			{Username: s.parameters["rpcUsername"], PasswordSecretName: password.Name},
+		},
+	}
+	k8s.DefaultResources(&bitcoin.Spec.Resources)
+
+	stacks := &stacksv1alpha1.Node{ObjectMeta: s.meta("stacks")}
+	stacks.Spec = stacksv1alpha1.NodeSpec{
+		Network: stacksv1alpha1.StacksNetwork(network),
+		BitcoinNode: stacksv1alpha1.BitcoinNode{
+			Endpoint:              bitcoin.Name,
+			P2pPort:               p2pPort,
+			RpcPort:               rpcPort,
+			RpcUsername:           s.parameters["rpcUsername"],
+			RpcPasswordSecretName: password.Name,
+		},
+	}
+	k8s.DefaultResources(&stacks.Spec.Resources)
+
+	return []client.Object{password, bitcoin, stacks}, nil
+}
+
+func buildChainlinkEthereum(s stack) ([]client.Object, error) {
+	keystorePassword, err := s.secret("keystore-password", "password", "password", randomPassword)
+	if err != nil {
+		return nil, err
+	}
+	apiPassword, err := s.secret("api-password", "password", "password", func() (string, error) {
+		return s.parameters["apiPassword"], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	network := s.parameters["network"]
+	ethereum := &ethereumv1alpha1.Node{ObjectMeta: s.meta("ethereum")}
+	ethereum.Spec = ethereumv1alpha1.NodeSpec{
+		Network: network,
+		Client:  ethereumv1alpha1.EthereumClient(s.parameters["executionClient"]),
+		RPC:     true,
+		RPCPort: ethereumv1alpha1.DefaultRPCPort,
+		WS:      true,
+		WSPort:  ethereumv1alpha1.DefaultWSPort,
+	}
+	k8s.DefaultResources(&ethereum.Spec.Resources)
+
+	linkContract := s.parameters["linkContractAddress"]
+	if linkContract == "" {
+		linkContract = linkContracts[network]
+	}
+
+	chainlink := &chainlinkv1alpha1.Node{ObjectMeta: s.meta("chainlink")}
+	chainlink.Spec = chainlinkv1alpha1.NodeSpec{
+		EthereumChainId:            chainIDs[network],
+		EthereumWSEndpoint:         fmt.Sprintf("ws://%s:%d", ethereum.Name, ethereumv1alpha1.DefaultWSPort),
+		EthereumHTTPEndpoints:      []string{fmt.Sprintf("http://%s:%d", ethereum.Name, ethereumv1alpha1.DefaultRPCPort)},
+		LinkContractAddress:        linkContract,
+		DatabaseURL:                s.parameters["databaseURL"],
+		KeystorePasswordSecretName: keystorePassword.Name,
+		APICredentials: chainlinkv1alpha1.APICredentials{
+			Email:              s.parameters["apiEmail"],
+			PasswordSecretName: apiPassword.Name,
+		},
+	}
+	k8s.DefaultResources(&chainlink.Spec.Resources)
+
+	return []client.Object{keystorePassword, apiPassword, ethereum, chainlink}, nil
+}
+
+// meta returns the metadata of the stack resource with the given suffix
+func (s stack) meta(suffix string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      fmt.Sprintf("%s-%s", s.name, suffix),
+		Namespace: s.namespace,
+		Labels: map[string]string{
+			StackLabel:    s.name,
+			TemplateLabel: s.template,
+		},
+	}
+}
+
+// secret returns an immutable secret of the stack storing the generated value at key
+func (s stack) secret(suffix, keyType, key string, generate func() (string, error)) (*corev1.Secret, error) {
+	value, err := generate()
+	if err != nil {
+		return nil, err
+	}
+
+	immutable := true
+	secret := &corev1.Secret{ObjectMeta: s.meta(suffix)}
+	secret.Labels["kotal.io/key-type"] = keyType
+	secret.Labels["app.kubernetes.io/created-by"] = "kotal-api"
+	secret.StringData = map[string]string{key: value}
+	secret.Immutable = &immutable
+	return secret, nil
+}
+
+// list returns the comma separated values of a parameter
+func (s stack) list(name string) []string {
+	var values []string
+	for _, value := range strings.Split(s.parameters[name], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// randomHex returns 32 random bytes hex encoded, the format of jwt and ipfs cluster secrets
+func randomHex() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// randomPassword returns a 32 characters password with lower and upper case letters, digits and symbols
+func randomPassword() (string, error) {
+	classes := []string{"abcdefghijkmnopqrstuvwxyz", "ABCDEFGHJKLMNPQRSTUVWXYZ", "23456789", "!#%+-.=?@^_"}
+	password := make([]byte, 32)
+	for i := range password {
+		// every class is used at least 3 times
+		charset := classes[i%len(classes)]
+		if i >= 3*len(classes) {
+			charset = strings.Join(classes, "")
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = charset[n.Int64()]
+	}
+	return string(password), nil
+}
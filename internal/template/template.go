@@ -0,0 +1,41 @@
+package template
+
+// TemplateDto is a blueprint of resources created together as a stack
+type TemplateDto struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Parameters  []ParameterDto `json:"parameters"`
+}
+
+// ParameterDto is a template parameter, Values are the allowed values if it's not empty
+type ParameterDto struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Default     string   `json:"default,omitempty"`
+	Values      []string `json:"values,omitempty"`
+}
+
+// StackDto is a request to create the resources of a template, resources are named after the stack
+type StackDto struct {
+	Name       string            `json:"name"`
+	Namespace  string            `json:"-"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+// StackResultDto is a created stack and its resources in creation order
+type StackResultDto struct {
+	Name      string        `json:"name"`
+	Template  string        `json:"template"`
+	Resources []ResourceDto `json:"resources"`
+}
+
+// ResourceDto is a resource created by a template
+// Protocol and Resource match the resource url groups, secrets are core secrets
+type ResourceDto struct {
+	Protocol string `json:"protocol"`
+	Resource string `json:"resource"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Link     string `json:"link"`
+}
@@ -0,0 +1,191 @@
+// Package template internal is the domain layer for stack templates
+// a template creates the resources of a multi-resource deployment in order, wires their references
+// and deletes the created resources if one of them fails
+package template
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kotalco/community-api/internal/core/name"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/kotalco/community-api/pkg/logger"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type templateService struct{}
+
+type IService interface {
+	List() []TemplateDto
+	Get(template string) (TemplateDto, restErrors.IRestErr)
+	Create(ctx context.Context, template string, dto StackDto) (StackResultDto, restErrors.IRestErr)
+}
+
+var (
+	k8sClient   = k8s.NewClientService()
+	nameService = name.NewNameService()
+)
+
+func NewTemplateService() IService {
+	return templateService{}
+}
+
+// List returns the built-in templates
+func (service templateService) List() []TemplateDto {
+	templates := make([]TemplateDto, 0, len(blueprints))
+	for _, b := range blueprints {
+		templates = append(templates, b.dto())
+	}
+	return templates
+}
+
+// Get returns a built-in template by name
+func (service templateService) Get(template string) (TemplateDto, restErrors.IRestErr) {
+	b, ok := find(template)
+	if !ok {
+		return TemplateDto{}, restErrors.NewNotFoundError(fmt.Sprintf("template by name %s doesn't exist", template))
+	}
+	return b.dto(), nil
+}
+
+// Create creates the resources of a template named after the stack
+// the resources already created are deleted in reverse order if one of them can't be created
+func (service templateService) Create(ctx context.Context, template string, dto StackDto) (StackResultDto, restErrors.IRestErr) {
+	b, ok := find(template)
+	if !ok {
+		return StackResultDto{}, restErrors.NewNotFoundError(fmt.Sprintf("template by name %s doesn't exist", template))
+	}
+
+	parameters, restErr := b.resolve(dto.Parameters)
+	if restErr != nil {
+		return StackResultDto{}, restErr
+	}
+
+	objects, err := b.build(stack{name: dto.Name, namespace: dto.Namespace, template: b.name, parameters: parameters})
+	if err != nil {
+		logger.ErrorContext(ctx, service.Create, err)
+		return StackResultDto{}, restErrors.NewInternalServerError(fmt.Sprintf("can't build %s stack", b.name))
+	}
+
+	if restErr = service.validateNames(ctx, objects); restErr != nil {
+		return StackResultDto{}, restErr
+	}
+
+	result := StackResultDto{Name: dto.Name, Template: b.name, Resources: make([]ResourceDto, 0, len(objects))}
+	for i, obj := range objects {
+		if err := k8sClient.Create(ctx, obj); err != nil {
+			logger.ErrorContext(ctx, service.Create, err)
+			service.rollback(ctx, objects[:i])
+
+			kind, _ := k8s.KindOf(obj)
+			message := fmt.Sprintf("can't create %s %s, the stack has been rolled back", kind.Kind, obj.GetName())
+			if apiErrors.IsInvalid(err) || apiErrors.IsBadRequest(err) {
+				return StackResultDto{}, restErrors.NewBadRequestError(fmt.Sprintf("%s: %s", message, err.Error()))
+			}
+			return StackResultDto{}, restErrors.NewInternalServerError(message)
+		}
+		result.Resources = append(result.Resources, newResource(obj))
+	}
+
+	return result, nil
+}
+
+// validateNames checks the names of the stack resources are valid and available
+func (service templateService) validateNames(ctx context.Context, objects []client.Object) restErrors.IRestErr {
+	for _, obj := range objects {
+		meta := k8s.MetaDataDto{Name: obj.GetName()}
+		if err := meta.Validate(); err != nil {
+			return err
+		}
+		available, err := nameService.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+		if err != nil {
+			return err
+		}
+		if !available.Available {
+			return restErrors.NewConflictError(fmt.Sprintf("resource %s already exists!", obj.GetName()))
+		}
+	}
+	return nil
+}
+
+// rollback deletes the created objects in reverse order, failures are logged and skipped
+func (service templateService) rollback(ctx context.Context, created []client.Object) {
+	for i := len(created) - 1; i >= 0; i-- {
+		if err := k8sClient.Delete(ctx, created[i]); err != nil && !apiErrors.IsNotFound(err) {
+			logger.ErrorContext(ctx, service.rollback, err)
+		}
+	}
+}
+
+func find(template string) (blueprint, bool) {
+	for _, b := range blueprints {
+		if b.name == template {
+			return b, true
+		}
+	}
+	return blueprint{}, false
+}
+
+func (b blueprint) dto() TemplateDto {
+	return TemplateDto{Name: b.name, Description: b.description, Parameters: b.parameters}
+}
+
+// resolve validates the given parameters against the template ones and fills in the defaults
+func (b blueprint) resolve(given map[string]string) (map[string]string, restErrors.IRestErr) {
+	fields := map[string]string{}
+	resolved := map[string]string{}
+	known := map[string]bool{}
+
+	for _, p := range b.parameters {
+		known[p.Name] = true
+		value := strings.TrimSpace(given[p.Name])
+		if value == "" {
+			value = p.Default
+		}
+		switch {
+		case value == "" && p.Required:
+			fields[p.Name] = fmt.Sprintf("%s is required", p.Name)
+		case value != "" && len(p.Values) > 0 && !contains(p.Values, value):
+			fields[p.Name] = fmt.Sprintf("%s must be one of %s", p.Name, strings.Join(p.Values, ", "))
+		}
+		resolved[p.Name] = value
+	}
+	for key := range given {
+		if !known[key] {
+			fields[key] = fmt.Sprintf("%s isn't a parameter of template %s", key, b.name)
+		}
+	}
+
+	if len(fields) > 0 {
+		return nil, restErrors.NewValidationError(fields)
+	}
+	return resolved, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func newResource(obj client.Object) ResourceDto {
+	kind, _ := k8s.KindOf(obj)
+	protocol := kind.Protocol
+	if protocol == "" {
+		protocol = "core"
+	}
+	return ResourceDto{
+		Protocol: protocol,
+		Resource: kind.Resource,
+		Kind:     kind.Kind,
+		Name:     obj.GetName(),
+		Link:     fmt.Sprintf("/api/v1/%s/%s/%s", protocol, kind.Resource, obj.GetName()),
+	}
+}
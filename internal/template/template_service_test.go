@@ -0,0 +1,147 @@
+package template
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kotalco/community-api/internal/core/name"
+	"github.com/kotalco/community-api/internal/testutil"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newClient(t *testing.T) *testutil.RecordingClient {
+	recording := testutil.NewClient(t)
+	k8sClient = recording
+	nameService = testutil.NameService{}
+	return recording
+}
+
+func TestResolve(t *testing.T) {
+	ethereum, _ := find("ethereum-full-stack")
+	chainlink, _ := find("chainlink-ethereum")
+
+	t.Run("defaults", func(t *testing.T) {
+		parameters, err := ethereum.resolve(map[string]string{"network": " goerli ", "feeRecipient": ""})
+		assert.Nil(t, err)
+		assert.Equal(t, ethereumv1alpha1.GoerliNetwork, parameters["network"])
+		assert.Equal(t, string(ethereumv1alpha1.GethClient), parameters["executionClient"])
+		assert.Equal(t, string(ethereum2v1alpha1.LighthouseClient), parameters["consensusClient"])
+		assert.Equal(t, "", parameters["feeRecipient"])
+		assert.Len(t, parameters, len(ethereum.parameters))
+	})
+
+	tests := []struct {
+		name      string
+		blueprint blueprint
+		given     map[string]string
+		fields    []string
+	}{
+		{"value isn't allowed", ethereum, map[string]string{"network": "ropsten"}, []string{"network"}},
+		{"unknown parameter", ethereum, map[string]string{"replicas": "3"}, []string{"replicas"}},
+		{"required parameters", chainlink, map[string]string{"apiEmail": "ops@example.com"}, []string{"databaseURL", "apiPassword"}},
+		{"blank required parameter", chainlink, map[string]string{"databaseURL": " ", "apiEmail": "ops@example.com", "apiPassword": "secret"}, []string{"databaseURL"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.blueprint.resolve(test.given)
+			assert.NotNil(t, err)
+			assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+			validations := err.(restErrors.RestErr).Validations
+			assert.Len(t, validations, len(test.fields))
+			for _, f := range test.fields {
+				assert.Contains(t, validations, f)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	dto := StackDto{
+		Name:       "eth",
+		Namespace:  "default",
+		Parameters: map[string]string{"consensusClient": "prysm", "validatorKeystores": "keystore-1, keystore-2"},
+	}
+
+	t.Run("creation order", func(t *testing.T) {
+		recording := newClient(t)
+		result, err := NewTemplateService().Create(ctx, "ethereum-full-stack", dto)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"eth-jwt", "eth-execution", "eth-beacon", "eth-validator"}, recording.Created)
+		assert.Empty(t, recording.Deleted)
+
+		assert.Equal(t, "ethereum-full-stack", result.Template)
+		assert.Equal(t, []ResourceDto{
+			{Protocol: "core", Resource: "secrets", Kind: "Secret", Name: "eth-jwt", Link: "/api/v1/core/secrets/eth-jwt"},
+			{Protocol: "ethereum", Resource: "nodes", Kind: "Node", Name: "eth-execution", Link: "/api/v1/ethereum/nodes/eth-execution"},
+			{Protocol: "ethereum2", Resource: "beaconnodes", Kind: "BeaconNode", Name: "eth-beacon", Link: "/api/v1/ethereum2/beaconnodes/eth-beacon"},
+			{Protocol: "ethereum2", Resource: "validators", Kind: "Validator", Name: "eth-validator", Link: "/api/v1/ethereum2/validators/eth-validator"},
+		}, result.Resources)
+
+		// references are wired to the resources created before
+		validator := &ethereum2v1alpha1.Validator{}
+		assert.NoError(t, recording.Get(ctx, types.NamespacedName{Namespace: "default", Name: "eth-validator"}, validator))
+		assert.Equal(t, []string{"eth-beacon:4000"}, validator.Spec.BeaconEndpoints)
+		assert.Len(t, validator.Spec.Keystores, 2)
+		assert.Equal(t, "eth", validator.Labels[StackLabel])
+		beacon := &ethereum2v1alpha1.BeaconNode{}
+		assert.NoError(t, recording.Get(ctx, types.NamespacedName{Namespace: "default", Name: "eth-beacon"}, beacon))
+		assert.Equal(t, "http://eth-execution:8551", beacon.Spec.ExecutionEngineEndpoint)
+		assert.Equal(t, "eth-jwt", beacon.Spec.JWTSecretName)
+	})
+
+	t.Run("rollback in reverse order", func(t *testing.T) {
+		recording := newClient(t)
+		recording.FailOn, recording.FailWith = "eth-validator", apiErrors.NewServiceUnavailable("etcd is unavailable")
+
+		_, err := NewTemplateService().Create(ctx, "ethereum-full-stack", dto)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, err.StatusCode())
+		assert.Equal(t, []string{"eth-jwt", "eth-execution", "eth-beacon"}, recording.Created)
+		assert.Equal(t, []string{"eth-beacon", "eth-execution", "eth-jwt"}, recording.Deleted)
+
+		secrets := &corev1.SecretList{}
+		assert.NoError(t, recording.List(ctx, secrets))
+		assert.Empty(t, secrets.Items)
+		nodes := &ethereumv1alpha1.NodeList{}
+		assert.NoError(t, recording.List(ctx, nodes))
+		assert.Empty(t, nodes.Items)
+	})
+
+	t.Run("invalid resource", func(t *testing.T) {
+		recording := newClient(t)
+		recording.FailOn = "eth-execution"
+		recording.FailWith = apiErrors.NewInvalid(schema.GroupKind{Group: "ethereum.kotal.io", Kind: "Node"}, "eth-execution", field.ErrorList{field.Invalid(field.NewPath("spec", "network"), "goerli", "invalid")})
+
+		_, err := NewTemplateService().Create(ctx, "ethereum-full-stack", dto)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+		assert.Equal(t, []string{"eth-jwt"}, recording.Deleted)
+	})
+
+	t.Run("name is taken", func(t *testing.T) {
+		recording := newClient(t)
+		nameService = testutil.NameService{Used: map[string]name.UsedByDto{"eth-beacon": {APIVersion: "ethereum2.kotal.io/v1alpha1", Kind: "BeaconNode"}}}
+
+		_, err := NewTemplateService().Create(ctx, "ethereum-full-stack", dto)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusConflict, err.StatusCode())
+		assert.Empty(t, recording.Created)
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		newClient(t)
+		_, err := NewTemplateService().Create(ctx, "solana", dto)
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode())
+	})
+}
@@ -0,0 +1,60 @@
+// Package testutil holds the fakes shared by the tests of the domain layer
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotalco/community-api/internal/core/name"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// RecordingClient is a fake client recording the names of the objects it creates and deletes
+// creating the object named FailOn fails with FailWith
+type RecordingClient struct {
+	client.Client
+	FailOn   string
+	FailWith error
+	Created  []string
+	Deleted  []string
+}
+
+// NewClient returns a recording client of a fake cluster with the kotal kinds and objects
+func NewClient(t testing.TB, objects ...client.Object) *RecordingClient {
+	scheme := runtime.NewScheme()
+	require.NoError(t, k8s.AddToScheme(scheme))
+	return &RecordingClient{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()}
+}
+
+func (c *RecordingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if obj.GetName() == c.FailOn {
+		return c.FailWith
+	}
+	c.Created = append(c.Created, obj.GetName())
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *RecordingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	c.Deleted = append(c.Deleted, obj.GetName())
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+// NameService is a name service mock, the names in Used are taken by the kind they map to
+type NameService struct {
+	Used map[string]name.UsedByDto
+}
+
+func (mock NameService) Get(_ context.Context, namespacedName types.NamespacedName) (name.NameDto, restErrors.IRestErr) {
+	dto := name.NameDto{Name: namespacedName.Name, UsedBy: []name.UsedByDto{}}
+	if usedBy, ok := mock.Used[namespacedName.Name]; ok {
+		dto.UsedBy = append(dto.UsedBy, usedBy)
+	}
+	dto.Available = len(dto.UsedBy) == 0
+	return dto, nil
+}
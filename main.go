@@ -1,24 +1,57 @@
 package main
 
 import (
+	"context"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/kotalco/community-api/api"
 	"github.com/kotalco/community-api/pkg/configs"
+	"github.com/kotalco/community-api/pkg/k8s"
+	apiLogger "github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/middleware"
 	"github.com/kotalco/community-api/pkg/server"
+	"github.com/kotalco/community-api/pkg/tracing"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"log"
+	"os"
 )
 
 func main() {
+	settings, err := configs.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	configs.Settings = settings
+
+	if err := apiLogger.Configure(settings.Log.LoggerOptions()); err != nil {
+		log.Fatalf("can't configure logger: %s", err)
+	}
+
+	shutdownTracing, err := tracing.Configure(context.Background(), settings.Tracing.TracingOptions())
+	if err != nil {
+		log.Fatalf("can't configure tracing: %s", err)
+	}
+
 	config := configs.FiberConfig()
 	app := fiber.New(config)
 
-	app.Use(logger.New())
+	app.Use(middleware.RequestID)
+	app.Use(middleware.Tracing)
+	app.Use(middleware.AccessLog)
 	app.Use(recover.New())
-	app.Use(cors.New())
+	app.Use(cors.New(configs.CorsConfig()))
 	api.MapUrl(app)
 
+	// discover installed kotal crds at startup, then periodically
+	k8s.StartDiscovery(settings.Kubernetes.DiscoveryInterval.Duration(), server.ShuttingDown())
+
 	server.StartServerWithGracefulShutdown(app)
+
+	ctx, cancel := context.WithTimeout(context.Background(), settings.Server.ShutdownTimeout.Duration())
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		apiLogger.Warn("SHUTDOWN_TRACING", err)
+	}
+	apiLogger.Sync()
 }
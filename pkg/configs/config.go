@@ -0,0 +1,453 @@
+package configs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/tracing"
+	"sigs.k8s.io/yaml"
+)
+
+const redacted = "*****"
+
+// Config is the api server configuration
+// it's loaded from an optional yaml file then overridden by environment variables
+type Config struct {
+	Environment string            `json:"environment"`
+	Server      ServerConfig      `json:"server"`
+	CORS        CORSConfig        `json:"cors"`
+	TLS         TLSConfig         `json:"tls"`
+	Log         LogConfig         `json:"log"`
+	Kubernetes  KubernetesConfig  `json:"kubernetes"`
+	Tracing     TracingConfig     `json:"tracing"`
+	RateLimit   RateLimitConfig   `json:"rateLimit"`
+	Idempotency IdempotencyConfig `json:"idempotency"`
+	Features    FeaturesConfig    `json:"features"`
+	Admin       AdminConfig       `json:"admin"`
+}
+
+type ServerConfig struct {
+	Port         int      `json:"port"`
+	ReadTimeout  Duration `json:"readTimeout"`
+	WriteTimeout Duration `json:"writeTimeout"`
+	IdleTimeout  Duration `json:"idleTimeout"`
+	// BodyLimit is the max request body size in bytes
+	BodyLimit int `json:"bodyLimit"`
+	// ShutdownTimeout is the deadline to drain open websockets and requests on shutdown
+	ShutdownTimeout Duration `json:"shutdownTimeout"`
+}
+
+type CORSConfig struct {
+	AllowOrigins []string `json:"allowOrigins"`
+}
+
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled"`
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	// ReloadInterval is how often the certificate files are checked for rotation
+	ReloadInterval Duration `json:"reloadInterval"`
+	// ClientCAFile enables client certificates verification (mTLS) against the given CA bundle
+	ClientCAFile string `json:"clientCAFile"`
+	// RequireClientCert rejects connections without a client certificate, otherwise it's verified only if given
+	RequireClientCert bool `json:"requireClientCert"`
+	// SelfSigned serves a generated self-signed certificate, for development only
+	SelfSigned bool `json:"selfSigned"`
+}
+
+type LogConfig struct {
+	Level string `json:"level"`
+	// Output is stdout, stderr or a file path
+	Output string `json:"output"`
+	// Format is json, or console for development
+	Format string        `json:"format"`
+	File   LogFileConfig `json:"file"`
+	// Components overrides the level of single components, it can be changed at runtime from the admin api
+	Components map[string]string `json:"components"`
+	// Sampling throttles the stats pollers logs
+	Sampling LogSamplingConfig `json:"sampling"`
+}
+
+// LogFileConfig writes the logs to a rotating json file besides the output
+type LogFileConfig struct {
+	// Path enables the log file if it's not empty
+	Path       string `json:"path"`
+	MaxSizeMB  int    `json:"maxSizeMB"`
+	MaxBackups int    `json:"maxBackups"`
+}
+
+// LogSamplingConfig writes the first Initial identical logs every second, then every Thereafter log
+type LogSamplingConfig struct {
+	Initial    int `json:"initial"`
+	Thereafter int `json:"thereafter"`
+}
+
+type KubernetesConfig struct {
+	// Kubeconfig is used out of cluster, defaults to $HOME/.kube/config
+	Kubeconfig        string   `json:"kubeconfig"`
+	QPS               float32  `json:"qps"`
+	Burst             int      `json:"burst"`
+	DiscoveryInterval Duration `json:"discoveryInterval"`
+}
+
+// TracingConfig exports opentelemetry spans of requests, kubernetes calls and stats pollers calls
+type TracingConfig struct {
+	// Exporter is none, stdout for local debugging, or otlp
+	Exporter string `json:"exporter"`
+	// Endpoint is the otlp http collector host:port
+	Endpoint string `json:"endpoint"`
+	Insecure bool   `json:"insecure"`
+	// SampleRatio is the ratio of traces that are sampled, between 0 and 1
+	SampleRatio float64 `json:"sampleRatio"`
+	ServiceName string  `json:"serviceName"`
+}
+
+// RateLimitConfig limits every client, identified by its identity or ip, to separate budgets
+// for reads, writes and websockets opens, and caps its concurrent websockets
+type RateLimitConfig struct {
+	Enabled        bool       `json:"enabled"`
+	Reads          RateBudget `json:"reads"`
+	Writes         RateBudget `json:"writes"`
+	WebsocketOpens RateBudget `json:"websocketOpens"`
+	// MaxStreamsPerClient caps the concurrent websockets of a single client, 0 is unlimited
+	MaxStreamsPerClient int `json:"maxStreamsPerClient"`
+}
+
+// RateBudget allows PerMinute requests a minute with bursts of up to Burst requests
+type RateBudget struct {
+	PerMinute int `json:"perMinute"`
+	Burst     int `json:"burst"`
+}
+
+// IdempotencyConfig controls the replay of create requests sent with an Idempotency-Key header
+type IdempotencyConfig struct {
+	// Retention is how long the response of a request is kept for replay
+	Retention Duration `json:"retention"`
+}
+
+// FeaturesConfig toggles optional api features
+type FeaturesConfig struct {
+	Logs    bool `json:"logs"`
+	Status  bool `json:"status"`
+	Stats   bool `json:"stats"`
+	Metrics bool `json:"metrics"`
+	Events  bool `json:"events"`
+}
+
+type AdminConfig struct {
+	// Token protects the admin api, the admin api is disabled if it's empty
+	Token string `json:"token"`
+}
+
+// Settings is the effective configuration of the running api server
+var Settings = Default()
+
+// Default returns the configuration used when neither a config file nor environment variables are set
+func Default() Config {
+	return Config{
+		Environment: "development",
+		Server: ServerConfig{
+			Port:            5000,
+			ReadTimeout:     Duration(60 * time.Second),
+			BodyLimit:       4 * 1024 * 1024,
+			ShutdownTimeout: Duration(30 * time.Second),
+		},
+		CORS: CORSConfig{
+			AllowOrigins: []string{"*"},
+		},
+		TLS: TLSConfig{
+			ReloadInterval: Duration(30 * time.Second),
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Output: "stdout",
+			Format: "json",
+			File: LogFileConfig{
+				MaxSizeMB:  100,
+				MaxBackups: 3,
+			},
+			Sampling: LogSamplingConfig{
+				Initial:    5,
+				Thereafter: 100,
+			},
+		},
+		Kubernetes: KubernetesConfig{
+			DiscoveryInterval: Duration(5 * time.Minute),
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+			ServiceName: "kotal-api",
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:             true,
+			Reads:               RateBudget{PerMinute: 600, Burst: 100},
+			Writes:              RateBudget{PerMinute: 60, Burst: 20},
+			WebsocketOpens:      RateBudget{PerMinute: 60, Burst: 30},
+			MaxStreamsPerClient: 50,
+		},
+		Idempotency: IdempotencyConfig{
+			Retention: Duration(24 * time.Hour),
+		},
+		Features: FeaturesConfig{
+			Logs:    true,
+			Status:  true,
+			Stats:   true,
+			Metrics: true,
+			Events:  true,
+		},
+	}
+}
+
+// Load returns the default configuration overridden by the yaml file at path if it's not empty, then by environment variables
+// the resulting configuration is validated
+func Load(path string) (Config, error) {
+	config := Default()
+
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return config, fmt.Errorf("can't read config file: %w", err)
+		}
+		if err = yaml.UnmarshalStrict(content, &config); err != nil {
+			return config, fmt.Errorf("can't parse config file %s: %w", path, err)
+		}
+	}
+
+	errs := config.applyEnvironment()
+	errs = append(errs, config.validate()...)
+	if len(errs) > 0 {
+		return config, ValidationError(errs)
+	}
+
+	return config, nil
+}
+
+// ValidationError lists every invalid configuration value
+type ValidationError []string
+
+func (err ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(err, "\n  - ")
+}
+
+func (config Config) validate() (errs []string) {
+	if config.Server.Port < 1 || config.Server.Port > 65535 {
+		errs = append(errs, fmt.Sprintf("server.port must be between 1 and 65535, got %d", config.Server.Port))
+	}
+	if config.Server.ReadTimeout < 0 {
+		errs = append(errs, "server.readTimeout can't be negative")
+	}
+	if config.Server.WriteTimeout < 0 {
+		errs = append(errs, "server.writeTimeout can't be negative")
+	}
+	if config.Server.IdleTimeout < 0 {
+		errs = append(errs, "server.idleTimeout can't be negative")
+	}
+	if config.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, "server.shutdownTimeout must be positive")
+	}
+	if config.Server.BodyLimit <= 0 {
+		errs = append(errs, fmt.Sprintf("server.bodyLimit must be positive, got %d", config.Server.BodyLimit))
+	}
+
+	for _, origin := range config.CORS.AllowOrigins {
+		if origin == "*" {
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("cors.allowOrigins %q must be * or a scheme://host origin", origin))
+		}
+	}
+
+	if config.TLS.Enabled {
+		if config.TLS.SelfSigned {
+			if config.Environment == "production" {
+				errs = append(errs, "tls.selfSigned can't be used in production environment")
+			}
+		} else if config.TLS.CertFile == "" || config.TLS.KeyFile == "" {
+			errs = append(errs, "tls.certFile and tls.keyFile are required when tls is enabled")
+		}
+		if config.TLS.RequireClientCert && config.TLS.ClientCAFile == "" {
+			errs = append(errs, "tls.requireClientCert requires tls.clientCAFile")
+		}
+		if config.TLS.ReloadInterval < 0 {
+			errs = append(errs, "tls.reloadInterval can't be negative")
+		}
+		for _, file := range []string{config.TLS.CertFile, config.TLS.KeyFile, config.TLS.ClientCAFile} {
+			if file == "" {
+				continue
+			}
+			if _, err := os.Stat(file); err != nil {
+				errs = append(errs, fmt.Sprintf("tls file %s isn't readable: %s", file, err))
+			}
+		}
+	} else if config.TLS.ClientCAFile != "" {
+		errs = append(errs, "tls.clientCAFile requires tls to be enabled")
+	}
+
+	if !validLogLevel(config.Log.Level) {
+		errs = append(errs, fmt.Sprintf("log.level must be one of debug, info, warn or error, got %q", config.Log.Level))
+	}
+	if config.Log.Output == "" {
+		errs = append(errs, "log.output can't be empty")
+	}
+	switch config.Log.Format {
+	case "json", "console":
+	default:
+		errs = append(errs, fmt.Sprintf("log.format must be json or console, got %q", config.Log.Format))
+	}
+	for component, level := range config.Log.Components {
+		if !validLogComponent(component) {
+			errs = append(errs, fmt.Sprintf("log.components must be any of %s, got %q", strings.Join(logger.Components, ", "), component))
+		} else if !validLogLevel(level) {
+			errs = append(errs, fmt.Sprintf("log.components.%s must be one of debug, info, warn or error, got %q", component, level))
+		}
+	}
+	if config.Log.File.MaxSizeMB <= 0 {
+		errs = append(errs, "log.file.maxSizeMB must be positive")
+	}
+	if config.Log.File.MaxBackups < 0 {
+		errs = append(errs, "log.file.maxBackups can't be negative")
+	}
+	if config.Log.Sampling.Initial < 0 || config.Log.Sampling.Thereafter < 0 {
+		errs = append(errs, "log.sampling can't be negative")
+	}
+
+	if config.Kubernetes.QPS < 0 {
+		errs = append(errs, "kubernetes.qps can't be negative")
+	}
+	if config.Kubernetes.Burst < 0 {
+		errs = append(errs, "kubernetes.burst can't be negative")
+	}
+	if config.Kubernetes.DiscoveryInterval < 0 {
+		errs = append(errs, "kubernetes.discoveryInterval can't be negative")
+	}
+
+	switch config.Tracing.Exporter {
+	case tracing.None, tracing.Stdout, tracing.OTLP:
+	default:
+		errs = append(errs, fmt.Sprintf("tracing.exporter must be one of none, stdout or otlp, got %q", config.Tracing.Exporter))
+	}
+	if config.Tracing.SampleRatio < 0 || config.Tracing.SampleRatio > 1 {
+		errs = append(errs, "tracing.sampleRatio must be between 0 and 1")
+	}
+
+	if config.RateLimit.Enabled {
+		for name, budget := range map[string]RateBudget{"reads": config.RateLimit.Reads, "writes": config.RateLimit.Writes, "websocketOpens": config.RateLimit.WebsocketOpens} {
+			if budget.PerMinute <= 0 || budget.Burst <= 0 {
+				errs = append(errs, fmt.Sprintf("rateLimit.%s perMinute and burst must be positive", name))
+			}
+		}
+	}
+	if config.RateLimit.MaxStreamsPerClient < 0 {
+		errs = append(errs, "rateLimit.maxStreamsPerClient can't be negative")
+	}
+
+	if config.Idempotency.Retention <= 0 {
+		errs = append(errs, "idempotency.retention must be positive")
+	}
+
+	return
+}
+
+func validLogLevel(level string) bool {
+	switch strings.ToLower(level) {
+	case "debug", "info", "warn", "error":
+		return true
+	}
+	return false
+}
+
+func validLogComponent(component string) bool {
+	for _, name := range logger.Components {
+		if name == component {
+			return true
+		}
+	}
+	return false
+}
+
+// LoggerOptions returns the logger options of the log configuration
+func (config LogConfig) LoggerOptions() logger.Options {
+	return logger.Options{
+		Level:  config.Level,
+		Output: config.Output,
+		Format: config.Format,
+		File: logger.FileOptions{
+			Path:       config.File.Path,
+			MaxSize:    config.File.MaxSizeMB,
+			MaxBackups: config.File.MaxBackups,
+		},
+		Components: config.Components,
+		Sampling: logger.SamplingOptions{
+			Initial:    config.Sampling.Initial,
+			Thereafter: config.Sampling.Thereafter,
+		},
+	}
+}
+
+// TracingOptions returns the tracing options of the tracing configuration
+func (config TracingConfig) TracingOptions() tracing.Options {
+	return tracing.Options{
+		Exporter:    config.Exporter,
+		Endpoint:    config.Endpoint,
+		Insecure:    config.Insecure,
+		SampleRatio: config.SampleRatio,
+		ServiceName: config.ServiceName,
+	}
+}
+
+// Redacted returns a copy of the configuration safe to be exposed
+func (config Config) Redacted() Config {
+	if config.Admin.Token != "" {
+		config.Admin.Token = redacted
+	}
+	config.CORS.AllowOrigins = append([]string{}, config.CORS.AllowOrigins...)
+	return config
+}
+
+// Duration is a time.Duration read from a go duration string like 1m30s or a number of seconds
+type Duration time.Duration
+
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(v * float64(time.Second))
+		return nil
+	case string:
+		return d.parse(v)
+	default:
+		return fmt.Errorf("invalid duration %s", string(b))
+	}
+}
+
+func (d *Duration) parse(value string) error {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		*d = Duration(time.Duration(seconds) * time.Second)
+		return nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q", value)
+	}
+	*d = Duration(parsed)
+	return nil
+}
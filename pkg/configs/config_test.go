@@ -0,0 +1,64 @@
+package configs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadDefaults(t *testing.T) {
+	config, err := Load("")
+	assert.Nil(t, err)
+	assert.EqualValues(t, Default(), config)
+}
+
+func TestLoadFileWithEnvironmentOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := []byte(`
+server:
+  port: 8080
+  readTimeout: 30s
+  writeTimeout: 15
+log:
+  level: debug
+`)
+	assert.Nil(t, os.WriteFile(path, content, 0600))
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("CORS_ALLOW_ORIGINS", "https://app.kotal.co, http://localhost:3000")
+	t.Setenv("ADMIN_TOKEN", "secret")
+	t.Setenv("LOG_COMPONENTS", "k8s=debug, stats=error")
+
+	config, err := Load(path)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 8080, config.Server.Port)
+	assert.EqualValues(t, 30*time.Second, config.Server.ReadTimeout.Duration())
+	assert.EqualValues(t, 15*time.Second, config.Server.WriteTimeout.Duration())
+	assert.EqualValues(t, "warn", config.Log.Level)
+	assert.EqualValues(t, map[string]string{"k8s": "debug", "stats": "error"}, config.Log.Components)
+	assert.EqualValues(t, []string{"https://app.kotal.co", "http://localhost:3000"}, config.CORS.AllowOrigins)
+	assert.EqualValues(t, redacted, config.Redacted().Admin.Token)
+	assert.EqualValues(t, "secret", config.Admin.Token)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Setenv("CLOUD_API_SERVER_PORT", "http")
+	t.Setenv("SERVER_READ_TIMEOUT", "forever")
+	t.Setenv("LOG_LEVEL", "verbose")
+	t.Setenv("TLS_ENABLED", "true")
+	t.Setenv("LOG_COMPONENTS", "kubernetes=debug")
+
+	_, err := Load("")
+	assert.NotNil(t, err)
+	assert.Len(t, err.(ValidationError), 5)
+}
+
+func TestLoadUnknownField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("server:\n  prot: 8080\n"), 0600))
+
+	_, err := Load(path)
+	assert.NotNil(t, err)
+}
@@ -1,27 +1,151 @@
 package configs
 
-import "os"
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
 
-// getenv returns environment variable by name or default value
-func getenv(name, defaultValue string) string {
-	if value := os.Getenv(name); value != "" {
-		return value
+// applyEnvironment overrides the configuration with the environment variables that are set
+// it returns an error message for every variable that can't be parsed
+func (config *Config) applyEnvironment() (errs []string) {
+	str := func(name string, field *string) {
+		if value := os.Getenv(name); value != "" {
+			*field = value
+		}
 	}
-	return defaultValue
-}
-
-var (
-	Environment = struct {
-		ServerPort        string
-		Environment       string
-		LogOutput         string
-		LogLevel          string
-		ServerReadTimeout string
-	}{
-		ServerPort:        getenv("CLOUD_API_SERVER_PORT", "5000"),
-		Environment:       getenv("ENVIRONMENT", "development"),
-		LogOutput:         getenv("LOG_OUTPUT", "stdout"),
-		LogLevel:          getenv("LOG_LEVEL", "info"),
-		ServerReadTimeout: getenv("SERVER_READ_TIMEOUT", "60"),
+	integer := func(name string, field *int) {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s must be an integer, got %q", name, value))
+				return
+			}
+			*field = parsed
+		}
 	}
-)
+	float := func(name string, field *float32) {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.ParseFloat(value, 32)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s must be a number, got %q", name, value))
+				return
+			}
+			*field = float32(parsed)
+		}
+	}
+	ratio := func(name string, field *float64) {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s must be a number, got %q", name, value))
+				return
+			}
+			*field = parsed
+		}
+	}
+	boolean := func(name string, field *bool) {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s must be true or false, got %q", name, value))
+				return
+			}
+			*field = parsed
+		}
+	}
+	duration := func(name string, field *Duration) {
+		if value := os.Getenv(name); value != "" {
+			if err := field.parse(value); err != nil {
+				errs = append(errs, fmt.Sprintf("%s must be a number of seconds or a duration like 1m30s, got %q", name, value))
+			}
+		}
+	}
+	levels := func(name string, field *map[string]string) {
+		if value := os.Getenv(name); value != "" {
+			items := map[string]string{}
+			for _, item := range strings.Split(value, ",") {
+				component, level, found := strings.Cut(strings.TrimSpace(item), "=")
+				if !found {
+					errs = append(errs, fmt.Sprintf("%s must be a list of component=level, got %q", name, value))
+					return
+				}
+				items[component] = level
+			}
+			*field = items
+		}
+	}
+	list := func(name string, field *[]string) {
+		if value := os.Getenv(name); value != "" {
+			items := []string{}
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			*field = items
+		}
+	}
+
+	str("ENVIRONMENT", &config.Environment)
+
+	integer("CLOUD_API_SERVER_PORT", &config.Server.Port)
+	duration("SERVER_READ_TIMEOUT", &config.Server.ReadTimeout)
+	duration("SERVER_WRITE_TIMEOUT", &config.Server.WriteTimeout)
+	duration("SERVER_IDLE_TIMEOUT", &config.Server.IdleTimeout)
+	integer("SERVER_BODY_LIMIT", &config.Server.BodyLimit)
+	duration("SERVER_SHUTDOWN_TIMEOUT", &config.Server.ShutdownTimeout)
+
+	list("CORS_ALLOW_ORIGINS", &config.CORS.AllowOrigins)
+
+	boolean("TLS_ENABLED", &config.TLS.Enabled)
+	str("TLS_CERT_FILE", &config.TLS.CertFile)
+	str("TLS_KEY_FILE", &config.TLS.KeyFile)
+	duration("TLS_RELOAD_INTERVAL", &config.TLS.ReloadInterval)
+	str("TLS_CLIENT_CA_FILE", &config.TLS.ClientCAFile)
+	boolean("TLS_REQUIRE_CLIENT_CERT", &config.TLS.RequireClientCert)
+	boolean("TLS_SELF_SIGNED", &config.TLS.SelfSigned)
+
+	str("LOG_LEVEL", &config.Log.Level)
+	str("LOG_OUTPUT", &config.Log.Output)
+	str("LOG_FORMAT", &config.Log.Format)
+	str("LOG_FILE", &config.Log.File.Path)
+	integer("LOG_FILE_MAX_SIZE_MB", &config.Log.File.MaxSizeMB)
+	integer("LOG_FILE_MAX_BACKUPS", &config.Log.File.MaxBackups)
+	levels("LOG_COMPONENTS", &config.Log.Components)
+	integer("LOG_SAMPLING_INITIAL", &config.Log.Sampling.Initial)
+	integer("LOG_SAMPLING_THEREAFTER", &config.Log.Sampling.Thereafter)
+
+	str("KUBECONFIG", &config.Kubernetes.Kubeconfig)
+	float("KUBE_QPS", &config.Kubernetes.QPS)
+	integer("KUBE_BURST", &config.Kubernetes.Burst)
+	duration("CRD_DISCOVERY_INTERVAL", &config.Kubernetes.DiscoveryInterval)
+
+	str("TRACING_EXPORTER", &config.Tracing.Exporter)
+	str("OTEL_EXPORTER_OTLP_ENDPOINT", &config.Tracing.Endpoint)
+	boolean("OTEL_EXPORTER_OTLP_INSECURE", &config.Tracing.Insecure)
+	ratio("TRACING_SAMPLE_RATIO", &config.Tracing.SampleRatio)
+	str("OTEL_SERVICE_NAME", &config.Tracing.ServiceName)
+
+	boolean("RATE_LIMIT_ENABLED", &config.RateLimit.Enabled)
+	integer("RATE_LIMIT_READS_PER_MINUTE", &config.RateLimit.Reads.PerMinute)
+	integer("RATE_LIMIT_READS_BURST", &config.RateLimit.Reads.Burst)
+	integer("RATE_LIMIT_WRITES_PER_MINUTE", &config.RateLimit.Writes.PerMinute)
+	integer("RATE_LIMIT_WRITES_BURST", &config.RateLimit.Writes.Burst)
+	integer("RATE_LIMIT_WEBSOCKETS_PER_MINUTE", &config.RateLimit.WebsocketOpens.PerMinute)
+	integer("RATE_LIMIT_WEBSOCKETS_BURST", &config.RateLimit.WebsocketOpens.Burst)
+	integer("RATE_LIMIT_MAX_STREAMS_PER_CLIENT", &config.RateLimit.MaxStreamsPerClient)
+
+	duration("IDEMPOTENCY_RETENTION", &config.Idempotency.Retention)
+
+	boolean("FEATURE_LOGS", &config.Features.Logs)
+	boolean("FEATURE_STATUS", &config.Features.Status)
+	boolean("FEATURE_STATS", &config.Features.Stats)
+	boolean("FEATURE_METRICS", &config.Features.Metrics)
+	boolean("FEATURE_EVENTS", &config.Features.Events)
+
+	str("ADMIN_TOKEN", &config.Admin.Token)
+
+	return
+}
@@ -2,25 +2,35 @@ package configs
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/logger"
-	"strconv"
-	"time"
+	"strings"
 )
 
+// FiberConfig returns the fiber server config from the loaded Settings
 func FiberConfig() fiber.Config {
-	readTimeoutSecondsCount, _ := strconv.Atoi(Environment.ServerReadTimeout)
 	return fiber.Config{
-		ReadTimeout:  time.Second * time.Duration(readTimeoutSecondsCount),
+		ReadTimeout:  Settings.Server.ReadTimeout.Duration(),
+		WriteTimeout: Settings.Server.WriteTimeout.Duration(),
+		IdleTimeout:  Settings.Server.IdleTimeout.Duration(),
+		BodyLimit:    Settings.Server.BodyLimit,
 		ErrorHandler: defaultErrorHandler,
 	}
 }
 
+// CorsConfig returns the cors middleware config from the loaded Settings
+func CorsConfig() cors.Config {
+	return cors.Config{
+		AllowOrigins: strings.Join(Settings.CORS.AllowOrigins, ","),
+	}
+}
+
 // defaultErrorHandler used to catch all unhandled  run time errors mainly panics
 // logs errors using logger pkg
 // return custom error struct using restError pkg
 var defaultErrorHandler = func(c *fiber.Ctx, err error) error {
-	go logger.Warn("DEFAULT_ERROR_HANDLER", err)
+	logger.Component(logger.HTTP).WarnContext(c.UserContext(), "DEFAULT_ERROR_HANDLER", err)
 
 	internalErr := restErrors.NewInternalServerError("some thing went wrong...")
 
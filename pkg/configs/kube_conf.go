@@ -13,10 +13,25 @@ import (
 )
 
 // KubeConfig returns REST config based on the environment
+// client rate limits are set from the loaded Settings
 func KubeConfig() (*rest.Config, error) {
+	config, err := kubeConfig()
+	if err != nil {
+		return nil, err
+	}
+	if Settings.Kubernetes.QPS > 0 {
+		config.QPS = Settings.Kubernetes.QPS
+	}
+	if Settings.Kubernetes.Burst > 0 {
+		config.Burst = Settings.Kubernetes.Burst
+	}
+	return config, nil
+}
+
+func kubeConfig() (*rest.Config, error) {
 
 	// if we're in k8s cluster, create in cluster config using service account
-	// otherwise, create out of cluster config using kubeconfig at $HOME/.kube/config
+	// otherwise, create out of cluster config using the configured kubeconfig or the one at $HOME/.kube/config
 	if os.Getenv("MOCK") == "true" {
 		log.Println("creating k8s client using test environment ...")
 		testEnv := envtest.Environment{
@@ -31,7 +46,10 @@ func KubeConfig() (*rest.Config, error) {
 		return rest.InClusterConfig()
 	} else {
 		log.Println("creating k8s client using out-of-cluster config ...")
-		kubeconfig := filepath.Join(homedir.HomeDir(), ".kube", "config")
+		kubeconfig := Settings.Kubernetes.Kubeconfig
+		if kubeconfig == "" {
+			kubeconfig = filepath.Join(homedir.HomeDir(), ".kube", "config")
+		}
 		return clientcmd.BuildConfigFromFlags("", kubeconfig)
 	}
 
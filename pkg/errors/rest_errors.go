@@ -14,6 +14,7 @@ type RestErr struct {
 	Status      int               `json:"status"`
 	Name        string            `json:"name"`
 	Validations map[string]string `json:"validations,omitempty"`
+	RequestID   string            `json:"requestId,omitempty"`
 }
 
 func NewRestErr() IRestErr {
@@ -89,3 +90,27 @@ func NewConflictError(message string) IRestErr {
 		Name:    "Conflict",
 	}
 }
+
+func NewNotImplementedError(message string) IRestErr {
+	return RestErr{
+		Message: message,
+		Status:  http.StatusNotImplemented,
+		Name:    "Not Implemented",
+	}
+}
+
+func NewServiceUnavailableError(message string) IRestErr {
+	return RestErr{
+		Message: message,
+		Status:  http.StatusServiceUnavailable,
+		Name:    "Service Unavailable",
+	}
+}
+
+func NewUnprocessableEntityError(message string) IRestErr {
+	return RestErr{
+		Message: message,
+		Status:  http.StatusUnprocessableEntity,
+		Name:    "Unprocessable Entity",
+	}
+}
@@ -22,3 +22,21 @@ func TestNewInternalServerError(t *testing.T) {
 	assert.EqualValues(t, err.Error(), "internal server error")
 	assert.EqualValues(t, http.StatusInternalServerError, err.StatusCode())
 }
+
+func TestNewNotImplementedError(t *testing.T) {
+	err := NewNotImplementedError("not implemented")
+	assert.EqualValues(t, err.Error(), "not implemented")
+	assert.EqualValues(t, http.StatusNotImplemented, err.StatusCode())
+}
+
+func TestNewServiceUnavailableError(t *testing.T) {
+	err := NewServiceUnavailableError("service unavailable")
+	assert.EqualValues(t, err.Error(), "service unavailable")
+	assert.EqualValues(t, http.StatusServiceUnavailable, err.StatusCode())
+}
+
+func TestNewUnprocessableEntityError(t *testing.T) {
+	err := NewUnprocessableEntityError("unprocessable entity")
+	assert.EqualValues(t, err.Error(), "unprocessable entity")
+	assert.EqualValues(t, http.StatusUnprocessableEntity, err.StatusCode())
+}
@@ -0,0 +1,111 @@
+// Package idempotency records the outcome of requests sent with an idempotency key
+// so retries of the same request are replayed instead of being executed again
+package idempotency
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+// cleanupInterval is how often expired records are removed
+const cleanupInterval = time.Minute
+
+// Response is the recorded outcome of a request
+type Response struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+type record struct {
+	bodyHash  [sha256.Size]byte
+	response  *Response
+	expiresAt time.Time
+}
+
+// Result of beginning a request with an idempotency key
+type Result int
+
+const (
+	// Started means the key is new, the request must be executed then completed or aborted
+	Started Result = iota
+	// Replay means the request has been executed before with the same body
+	Replay
+	// InProgress means a request with the same key is still being executed
+	InProgress
+	// Mismatch means the key has been used before with a different body
+	Mismatch
+)
+
+// Store keeps the outcome of requests by key for the retention window
+type Store struct {
+	lock        sync.Mutex
+	retention   time.Duration
+	records     map[string]*record
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+// NewStore returns an in-memory store keeping outcomes for retention
+func NewStore(retention time.Duration) *Store {
+	return &Store{
+		retention: retention,
+		records:   map[string]*record{},
+		now:       time.Now,
+	}
+}
+
+// Begin starts a request with key and body
+// the recorded response is returned if the result is Replay
+func (s *Store) Begin(key string, body []byte) (Result, *Response) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	s.cleanup(now)
+
+	hash := sha256.Sum256(body)
+	if r, ok := s.records[key]; ok && now.Before(r.expiresAt) {
+		switch {
+		case r.bodyHash != hash:
+			return Mismatch, nil
+		case r.response == nil:
+			return InProgress, nil
+		default:
+			return Replay, r.response
+		}
+	}
+
+	s.records[key] = &record{bodyHash: hash, expiresAt: now.Add(s.retention)}
+	return Started, nil
+}
+
+// Complete records the response of the request started with key
+func (s *Store) Complete(key string, response Response) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if r, ok := s.records[key]; ok {
+		r.response = &response
+		r.expiresAt = s.now().Add(s.retention)
+	}
+}
+
+// Abort forgets the request started with key so it can be retried
+func (s *Store) Abort(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.records, key)
+}
+
+func (s *Store) cleanup(now time.Time) {
+	if now.Sub(s.lastCleanup) < cleanupInterval {
+		return
+	}
+	s.lastCleanup = now
+	for key, r := range s.records {
+		if !now.Before(r.expiresAt) {
+			delete(s.records, key)
+		}
+	}
+}
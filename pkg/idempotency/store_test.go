@@ -0,0 +1,41 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	now := time.Now()
+	store := NewStore(time.Hour)
+	store.now = func() time.Time { return now }
+
+	body := []byte(`{"name":"my-node"}`)
+
+	result, _ := store.Begin("key", body)
+	assert.Equal(t, Started, result)
+
+	result, _ = store.Begin("key", body)
+	assert.Equal(t, InProgress, result)
+
+	store.Complete("key", Response{Status: 201, Body: []byte(`{"data":{}}`)})
+
+	result, response := store.Begin("key", body)
+	assert.Equal(t, Replay, result)
+	assert.Equal(t, 201, response.Status)
+
+	result, _ = store.Begin("key", []byte(`{"name":"other-node"}`))
+	assert.Equal(t, Mismatch, result)
+
+	// expired records are forgotten
+	now = now.Add(time.Hour)
+	result, _ = store.Begin("key", []byte(`{"name":"other-node"}`))
+	assert.Equal(t, Started, result)
+
+	// aborted requests can be retried
+	store.Abort("key")
+	result, _ = store.Begin("key", body)
+	assert.Equal(t, Started, result)
+}
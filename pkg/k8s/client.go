@@ -2,11 +2,13 @@ package k8s
 
 import (
 	"context"
+	"fmt"
 	bitcoinv1alpha1 "github.com/kotalco/kotal/apis/bitcoin/v1alpha1"
 	"sync"
 
 	"github.com/kotalco/community-api/pkg/configs"
 	"github.com/kotalco/community-api/pkg/logger"
+	"github.com/kotalco/community-api/pkg/tracing"
 	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
 	chainlinkv1alpha1 "github.com/kotalco/kotal/apis/chainlink/v1alpha1"
 	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
@@ -16,16 +18,22 @@ import (
 	nearv1alpha1 "github.com/kotalco/kotal/apis/near/v1alpha1"
 	polkadotv1alpha1 "github.com/kotalco/kotal/apis/polkadot/v1alpha1"
 	stacksv1alpha1 "github.com/kotalco/kotal/apis/stacks/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
 var (
 	clientLock              = &sync.Mutex{}
 	controllerRuntimeClient client.Client
 	RunTimeScheme           = runtime.NewScheme()
+	k8sLogger               = logger.Component(logger.K8s)
 )
 
 func newClient() client.Client {
@@ -35,7 +43,7 @@ func newClient() client.Client {
 	if controllerRuntimeClient == nil {
 		controllerRuntimeClient, err = newRuntimeClient()
 		if err != nil {
-			logger.Warn("K8S_CLIENT", err)
+			k8sLogger.Warn("K8S_CLIENT", err)
 		}
 	}
 
@@ -49,23 +57,37 @@ func newRuntimeClient() (client.Client, error) {
 		return nil, err
 	}
 
-	clientgoscheme.AddToScheme(RunTimeScheme)
-	bitcoinv1alpha1.AddToScheme(RunTimeScheme)
-	ethereumv1alpha1.AddToScheme(RunTimeScheme)
-	ethereum2v1alpha1.AddToScheme(RunTimeScheme)
-	ipfsv1alpha1.AddToScheme(RunTimeScheme)
-	filecoinv1alpha1.AddToScheme(RunTimeScheme)
-	chainlinkv1alpha1.AddToScheme(RunTimeScheme)
-	polkadotv1alpha1.AddToScheme(RunTimeScheme)
-	nearv1alpha1.AddToScheme(RunTimeScheme)
-	stacksv1alpha1.AddToScheme(RunTimeScheme)
-	aptosv1alpha1.AddToScheme(RunTimeScheme)
+	if err = AddToScheme(RunTimeScheme); err != nil {
+		return nil, err
+	}
 
 	opts := client.Options{Scheme: RunTimeScheme}
 
 	return client.New(config, opts)
 }
 
+// AddToScheme adds the kubernetes and kotal kinds to scheme
+func AddToScheme(scheme *runtime.Scheme) error {
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		bitcoinv1alpha1.AddToScheme,
+		ethereumv1alpha1.AddToScheme,
+		ethereum2v1alpha1.AddToScheme,
+		ipfsv1alpha1.AddToScheme,
+		filecoinv1alpha1.AddToScheme,
+		chainlinkv1alpha1.AddToScheme,
+		polkadotv1alpha1.AddToScheme,
+		nearv1alpha1.AddToScheme,
+		stacksv1alpha1.AddToScheme,
+		aptosv1alpha1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type k8sClientService struct{}
 type ObjectKey = types.NamespacedName
 
@@ -82,41 +104,86 @@ func NewClientService() K8sClientServiceInterface {
 // Get retrieves an obj for the given object key from the Kubernetes Cluster.
 // obj must be a struct pointer so that obj can be updated with the response
 // returned by the Server.
-func (k8sClient k8sClientService) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+func (k8sClient k8sClientService) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) (err error) {
+	ctx, span := startSpan(ctx, "Get", obj, key.Namespace, key.Name)
+	defer func() { tracing.End(span, ignoreNotFound(err)) }()
 	return newClient().Get(ctx, key, obj)
 }
 
 // List retrieves list of objects for a given namespace and list options. On a
 // successful call, Items field in the list will be populated with the
 // result returned from the server.
-func (k8sClient k8sClientService) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+func (k8sClient k8sClientService) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (err error) {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	ctx, span := startSpan(ctx, "List", list, listOpts.Namespace, "")
+	defer func() { tracing.End(span, err) }()
 	return newClient().List(ctx, list, opts...)
 
 }
 
 // Create saves the object obj in the Kubernetes cluster.
-func (k8sClient k8sClientService) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+func (k8sClient k8sClientService) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) (err error) {
+	ctx, span := startSpan(ctx, "Create", obj, obj.GetNamespace(), obj.GetName())
+	defer func() { tracing.End(span, err) }()
 	return newClient().Create(ctx, obj, opts...)
 }
 
 // Delete deletes the given obj from Kubernetes cluster.
-func (k8sClient k8sClientService) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+func (k8sClient k8sClientService) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) (err error) {
+	ctx, span := startSpan(ctx, "Delete", obj, obj.GetNamespace(), obj.GetName())
+	defer func() { tracing.End(span, err) }()
 	return newClient().Delete(ctx, obj, opts...)
 }
 
 // Update updates the given obj in the Kubernetes cluster. obj must be a
 // struct pointer so that obj can be updated with the content returned by the Server.
-func (k8sClient k8sClientService) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+func (k8sClient k8sClientService) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) (err error) {
+	ctx, span := startSpan(ctx, "Update", obj, obj.GetNamespace(), obj.GetName())
+	defer func() { tracing.End(span, err) }()
 	return newClient().Update(ctx, obj, opts...)
 }
 
 // Patch patches the given obj in the Kubernetes cluster. obj must be a
 // struct pointer so that obj can be updated with the content returned by the Server.
-func (k8sClient k8sClientService) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+func (k8sClient k8sClientService) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) (err error) {
+	ctx, span := startSpan(ctx, "Patch", obj, obj.GetNamespace(), obj.GetName())
+	defer func() { tracing.End(span, err) }()
 	return newClient().Patch(ctx, obj, patch, opts...)
 }
 
 // DeleteAllOf deletes all objects of the given type matching the given options.
-func (k8sClient k8sClientService) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+func (k8sClient k8sClientService) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) (err error) {
+	ctx, span := startSpan(ctx, "DeleteAllOf", obj, obj.GetNamespace(), "")
+	defer func() { tracing.End(span, err) }()
 	return newClient().DeleteAllOf(ctx, obj, opts...)
 }
+
+// startSpan starts a client span for a kubernetes api call on obj named after the verb and the object kind
+func startSpan(ctx context.Context, verb string, obj runtime.Object, namespace, name string) (context.Context, trace.Span) {
+	kind := fmt.Sprintf("%T", obj)
+	if gvk, err := apiutil.GVKForObject(obj, RunTimeScheme); err == nil {
+		kind = gvk.GroupKind().String()
+	}
+
+	attributes := []attribute.KeyValue{
+		attribute.String("k8s.verb", verb),
+		attribute.String("k8s.kind", kind),
+	}
+	if namespace != "" {
+		attributes = append(attributes, semconv.K8SNamespaceNameKey.String(namespace))
+	}
+	if name != "" {
+		attributes = append(attributes, attribute.String("k8s.name", name))
+	}
+
+	return tracing.Tracer().Start(ctx, fmt.Sprintf("k8s %s %s", verb, kind), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+// ignoreNotFound returns nil if err is a not found error, not found resources are expected and aren't recorded as span errors
+func ignoreNotFound(err error) error {
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -2,7 +2,6 @@ package k8s
 
 import (
 	"github.com/kotalco/community-api/pkg/configs"
-	"github.com/kotalco/community-api/pkg/logger"
 	"k8s.io/client-go/kubernetes"
 	"sync"
 )
@@ -18,7 +17,7 @@ func Clientset() *kubernetes.Clientset {
 	if KubernetesClientset == nil {
 		KubernetesClientset, err = NewClientset()
 		if err != nil {
-			logger.Warn("K8S_CLIENT_SET", err)
+			k8sLogger.Warn("K8S_CLIENT_SET", err)
 		}
 	}
 
@@ -0,0 +1,182 @@
+package k8s
+
+import (
+	"regexp"
+
+	"github.com/kotalco/community-api/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// oomKilledReason is the termination reason of containers killed for exceeding their memory limit
+const oomKilledReason = "OOMKilled"
+
+// TerminationDto is how and when a container last terminated
+type TerminationDto struct {
+	ExitCode   int32  `json:"exitCode"`
+	Signal     int32  `json:"signal,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Message    string `json:"message,omitempty"`
+	OOMKilled  bool   `json:"oomKilled"`
+	StartedAt  string `json:"startedAt,omitempty"`
+	FinishedAt string `json:"finishedAt,omitempty"`
+}
+
+// HintDto is a known failure of a client with how to fix it
+type HintDto struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	Suggestion string `json:"suggestion"`
+}
+
+// hint is a known failure matched by the termination or waiting reason, the exit code or the logs of a container
+type hint struct {
+	HintDto
+	reasons   []string
+	exitCodes []int32
+	logs      *regexp.Regexp
+	// excludedReasons are the reasons explained by a more specific hint
+	excludedReasons []string
+}
+
+// hintCatalog are the common failures of the clients deployed by kotal
+var hintCatalog = []hint{
+	{
+		HintDto: HintDto{
+			ID:         "out-of-memory",
+			Title:      "Out of memory",
+			Suggestion: "the container was killed for exceeding its memory limit, raise the memory of the resource or lower the cache of the client",
+		},
+		reasons: []string{oomKilledReason},
+	},
+	{
+		HintDto: HintDto{
+			ID:         "killed",
+			Title:      "Killed",
+			Suggestion: "the container was killed by SIGKILL, usually after failing its liveness probe or because the node ran out of memory",
+		},
+		exitCodes:       []int32{137},
+		excludedReasons: []string{oomKilledReason},
+	},
+	{
+		HintDto: HintDto{
+			ID:         "out-of-disk",
+			Title:      "Out of disk",
+			Suggestion: "the volume of the resource is full, raise its storage, the storage class must allow volume expansion",
+		},
+		logs: regexp.MustCompile(`(?i)no space left on device|disk full|ENOSPC|not enough disk space`),
+	},
+	{
+		HintDto: HintDto{
+			ID:         "corrupt-database",
+			Title:      "Corrupt database",
+			Suggestion: "the database of the client is corrupted, usually after an unclean shutdown, delete the resource data to resync it",
+		},
+		logs: regexp.MustCompile(`(?i)corrupt(ed|ion)|missing trie node|invalid checksum|MDBX_CORRUPTED|database (is )?in an inconsistent state`),
+	},
+	{
+		HintDto: HintDto{
+			ID:         "database-locked",
+			Title:      "Database locked",
+			Suggestion: "the database is locked by another process, make sure a single client uses the volume",
+		},
+		logs: regexp.MustCompile(`(?i)database (is )?locked|lock held by|LOCK: resource temporarily unavailable|datadir already used`),
+	},
+	{
+		HintDto: HintDto{
+			ID:         "jwt-mismatch",
+			Title:      "JWT mismatch",
+			Suggestion: "the execution and beacon nodes don't share the same JWT secret, set the same jwtSecretName on both",
+		},
+		logs: regexp.MustCompile(`(?i)(invalid|wrong|mismatch(ed)?|failed to (verify|validate)).{0,20}jwt|jwt.{0,40}(invalid|mismatch|unauthorized|failed|expired)|engine api.{0,40}(unauthorized|401)|signature is invalid`),
+	},
+	{
+		HintDto: HintDto{
+			ID:         "unknown-flag",
+			Title:      "Unsupported argument",
+			Suggestion: "the client doesn't support an argument, check the image version is supported by kotal or remove the extra arguments",
+		},
+		logs: regexp.MustCompile(`(?i)flag provided but not defined|unknown (flag|option|argument)|unrecognized (flag|option|argument)|unexpected argument`),
+	},
+	{
+		HintDto: HintDto{
+			ID:         "permission-denied",
+			Title:      "Permission denied",
+			Suggestion: "the client can't access its data directory, check the volume isn't shared with a client running as another user",
+		},
+		logs: regexp.MustCompile(`(?i)permission denied|operation not permitted`),
+	},
+	{
+		HintDto: HintDto{
+			ID:         "image-pull",
+			Title:      "Image can't be pulled",
+			Suggestion: "the image of the resource doesn't exist or the registry isn't reachable, check the image name and tag",
+		},
+		reasons: []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName"},
+	},
+	{
+		HintDto: HintDto{
+			ID:         "missing-secret",
+			Title:      "Missing secret or config map",
+			Suggestion: "a secret or a config map the container needs doesn't exist, create the secrets referenced by the resource",
+		},
+		reasons: []string{"CreateContainerConfigError"},
+	},
+}
+
+// LastTermination returns how a container last terminated, nil if it never did
+// previous reports whether it was restarted since, its logs are the previous logs of the container
+func LastTermination(status corev1.ContainerStatus) (termination *TerminationDto, previous bool) {
+	terminated := status.State.Terminated
+	if terminated == nil {
+		terminated, previous = status.LastTerminationState.Terminated, true
+	}
+	if terminated == nil {
+		return nil, false
+	}
+
+	termination = &TerminationDto{
+		ExitCode:  terminated.ExitCode,
+		Signal:    terminated.Signal,
+		Reason:    terminated.Reason,
+		Message:   terminated.Message,
+		OOMKilled: terminated.Reason == oomKilledReason,
+	}
+	if !terminated.StartedAt.IsZero() {
+		termination.StartedAt = terminated.StartedAt.UTC().Format(shared.JavascriptISOString)
+	}
+	if !terminated.FinishedAt.IsZero() {
+		termination.FinishedAt = terminated.FinishedAt.UTC().Format(shared.JavascriptISOString)
+	}
+	return termination, previous
+}
+
+// MatchHints returns the hints of the catalog matching the termination or waiting reason, the exit code or the logs of a container
+// exit code 0 and empty reason or logs match nothing
+func MatchHints(reason string, exitCode int32, logs string) []HintDto {
+	hints := make([]HintDto, 0)
+	for _, hint := range hintCatalog {
+		if hint.matches(reason, exitCode, logs) {
+			hints = append(hints, hint.HintDto)
+		}
+	}
+	return hints
+}
+
+func (h hint) matches(reason string, exitCode int32, logs string) bool {
+	for _, r := range h.excludedReasons {
+		if reason == r {
+			return false
+		}
+	}
+	for _, r := range h.reasons {
+		if reason != "" && reason == r {
+			return true
+		}
+	}
+	for _, code := range h.exitCodes {
+		if exitCode != 0 && exitCode == code {
+			return true
+		}
+	}
+	return h.logs != nil && logs != "" && h.logs.MatchString(logs)
+}
@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestLastTermination(t *testing.T) {
+	t.Run("never terminated", func(t *testing.T) {
+		termination, previous := LastTermination(corev1.ContainerStatus{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}})
+		assert.Nil(t, termination)
+		assert.False(t, previous)
+	})
+
+	t.Run("restarted", func(t *testing.T) {
+		termination, previous := LastTermination(corev1.ContainerStatus{
+			State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+		})
+		assert.True(t, previous)
+		assert.Equal(t, &TerminationDto{ExitCode: 137, Reason: "OOMKilled", OOMKilled: true}, termination)
+	})
+
+	t.Run("terminated", func(t *testing.T) {
+		termination, previous := LastTermination(corev1.ContainerStatus{
+			State:                corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+		})
+		assert.False(t, previous)
+		assert.Equal(t, int32(1), termination.ExitCode)
+		assert.False(t, termination.OOMKilled)
+	})
+}
+
+func TestMatchHints(t *testing.T) {
+	ids := func(hints []HintDto) []string {
+		result := make([]string, 0, len(hints))
+		for _, hint := range hints {
+			result = append(result, hint.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		reason   string
+		exitCode int32
+		logs     string
+		hints    []string
+	}{
+		{"healthy", "", 0, "INFO [05-20|12:00:00.000] Loaded JWT secret file", []string{}},
+		{"oom", "OOMKilled", 137, "", []string{"out-of-memory"}},
+		{"sigkill", "Error", 137, "", []string{"killed"}},
+		{"out of disk", "Error", 1, "Fatal: write /data/geth/chaindata/000123.ldb: no space left on device", []string{"out-of-disk"}},
+		{"corrupt database", "Error", 1, "Fatal: Failed to register the Ethereum service: missing trie node 5e2a...", []string{"corrupt-database"}},
+		{"database locked", "Error", 1, "Fatal: resource temporarily unavailable, LOCK: resource temporarily unavailable", []string{"database-locked"}},
+		{"jwt mismatch", "", 0, "ERROR execution: could not connect to engine api: 401 Unauthorized: signature is invalid", []string{"jwt-mismatch"}},
+		{"unknown flag", "Error", 2, "flag provided but not defined: -http.vhost", []string{"unknown-flag"}},
+		{"image pull", "ImagePullBackOff", 0, "", []string{"image-pull"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.hints, ids(MatchHints(test.reason, test.exitCode, test.logs)))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package k8s
+
+import (
+	"sync"
+	"time"
+
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KotalGroupVersions returns the kotal operator api groups the api server knows how to serve, in the order of KotalKinds
+func KotalGroupVersions() []schema.GroupVersion {
+	groupVersions := make([]schema.GroupVersion, 0, len(KotalKinds))
+	seen := map[schema.GroupVersion]bool{}
+	for _, kind := range KotalKinds {
+		if gv := kind.GroupVersion(); !seen[gv] {
+			seen[gv] = true
+			groupVersions = append(groupVersions, gv)
+		}
+	}
+	return groupVersions
+}
+
+// MetricsGroupVersion is the resource metrics api group served by metrics-server
+var MetricsGroupVersion = schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}
+
+// PodMetricsResource is the pod metrics resource used by the metrics websocket
+var PodMetricsResource = MetricsGroupVersion.WithResource("pods")
+
+type IDiscovery interface {
+	// Refresh queries the cluster for the kotal resources it serves
+	Refresh() error
+	// IsServed reports whether the cluster serves the given resource
+	IsServed(schema.GroupVersionResource) bool
+	// LastRefresh returns the time of the last successful discovery, zero if it never succeeded
+	LastRefresh() time.Time
+	// Err returns the error of the last discovery, nil if it succeeded
+	Err() error
+}
+
+type discovery struct {
+	lock        sync.RWMutex
+	served      map[schema.GroupVersionResource]bool
+	lastRefresh time.Time
+	err         error
+}
+
+var (
+	discoveryOnce     sync.Once
+	discoveryInstance *discovery
+)
+
+// Discovery returns the kotal crd discovery cache, creating it once
+func Discovery() IDiscovery {
+	discoveryOnce.Do(func() {
+		discoveryInstance = &discovery{served: map[schema.GroupVersionResource]bool{}}
+	})
+	return discoveryInstance
+}
+
+// StartDiscovery runs the crd discovery in the background now then every interval until stop is closed
+// a non-positive interval runs the discovery only once
+func StartDiscovery(interval time.Duration, stop <-chan struct{}) {
+	d := Discovery()
+	go func() {
+		if err := d.Refresh(); err != nil {
+			k8sLogger.Warn("K8S_DISCOVERY", err)
+		}
+		if interval <= 0 {
+			return
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := d.Refresh(); err != nil {
+					k8sLogger.Warn("K8S_DISCOVERY", err)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Refresh queries the api server for every kotal group version and the metrics api then caches the resources they serve
+// a group version that isn't installed in the cluster is recorded as not served
+// only errors of the kotal group versions fail the refresh, the metrics api is optional
+func (d *discovery) Refresh() error {
+	var served map[schema.GroupVersionResource]bool
+	var err error
+	if clientset := Clientset(); clientset == nil {
+		err = apiErrors.NewServiceUnavailable("k8s client set isn't available")
+	} else {
+		served, err = discover(clientset.Discovery())
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.err = err
+	if err != nil {
+		return err
+	}
+	d.served = served
+	d.lastRefresh = time.Now()
+
+	return nil
+}
+
+// resourceLister lists the resources of a group version, it's implemented by the client-go discovery client
+type resourceLister interface {
+	ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+}
+
+// discover returns the resources served by the kotal group versions and the metrics api
+// any error of the metrics api, like a 503 of an unhealthy metrics-server, is logged and the metrics api recorded as not served
+func discover(lister resourceLister) (map[schema.GroupVersionResource]bool, error) {
+	served, err := discoverGroupVersions(lister, KotalGroupVersions())
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := discoverGroupVersions(lister, []schema.GroupVersion{MetricsGroupVersion})
+	if err != nil {
+		k8sLogger.Warn("K8S_DISCOVERY_METRICS", err)
+		return served, nil
+	}
+	for gvr := range metrics {
+		served[gvr] = true
+	}
+
+	return served, nil
+}
+
+// discoverGroupVersions returns the resources served by groupVersions, group versions that aren't installed are skipped
+func discoverGroupVersions(lister resourceLister, groupVersions []schema.GroupVersion) (map[schema.GroupVersionResource]bool, error) {
+	served := map[schema.GroupVersionResource]bool{}
+	for _, gv := range groupVersions {
+		list, err := lister.ServerResourcesForGroupVersion(gv.String())
+		if err != nil {
+			if apiErrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, resource := range list.APIResources {
+			served[gv.WithResource(resource.Name)] = true
+		}
+	}
+
+	return served, nil
+}
+
+// IsServed reports whether the cluster serves the given resource
+// until discovery succeeds once every resource is assumed to be served
+func (d *discovery) IsServed(gvr schema.GroupVersionResource) bool {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	if d.lastRefresh.IsZero() {
+		return true
+	}
+	return d.served[gvr]
+}
+
+func (d *discovery) LastRefresh() time.Time {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.lastRefresh
+}
+
+func (d *discovery) Err() error {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.err
+}
@@ -0,0 +1,94 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestKotalGroupVersions(t *testing.T) {
+	groupVersions := KotalGroupVersions()
+	assert.Len(t, groupVersions, 10)
+
+	for _, kind := range KotalKinds {
+		assert.Contains(t, groupVersions, kind.GroupVersion())
+	}
+}
+
+func TestDiscoveryIsServed(t *testing.T) {
+	ipfsPeers := schema.GroupVersionResource{Group: "ipfs.kotal.io", Version: "v1alpha1", Resource: "peers"}
+	ipfsClusterPeers := ipfsPeers.GroupVersion().WithResource("clusterpeers")
+
+	t.Run("before the first discovery", func(t *testing.T) {
+		d := &discovery{}
+		assert.True(t, d.IsServed(ipfsPeers))
+		assert.True(t, d.IsServed(PodMetricsResource))
+	})
+
+	t.Run("after discovery", func(t *testing.T) {
+		d := &discovery{served: map[schema.GroupVersionResource]bool{ipfsPeers: true}, lastRefresh: time.Now()}
+		assert.True(t, d.IsServed(ipfsPeers))
+		assert.False(t, d.IsServed(ipfsClusterPeers))
+		assert.False(t, d.IsServed(PodMetricsResource))
+	})
+}
+
+// resources is a resource lister serving the resources of group versions, group versions without resources fail with their error
+type resources struct {
+	served map[string][]string
+	errors map[string]error
+}
+
+func (r resources) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	if err, ok := r.errors[groupVersion]; ok {
+		return nil, err
+	}
+	names, ok := r.served[groupVersion]
+	if !ok {
+		return nil, apiErrors.NewNotFound(schema.GroupResource{}, groupVersion)
+	}
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, name := range names {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: name})
+	}
+	return list, nil
+}
+
+func TestDiscover(t *testing.T) {
+	ipfs := "ipfs.kotal.io/v1alpha1"
+	ipfsPeers := schema.GroupVersionResource{Group: "ipfs.kotal.io", Version: "v1alpha1", Resource: "peers"}
+	unavailable := apiErrors.NewServiceUnavailable("the server is currently unable to handle the request")
+
+	t.Run("metrics served", func(t *testing.T) {
+		served, err := discover(resources{served: map[string][]string{ipfs: {"peers"}, MetricsGroupVersion.String(): {"pods", "nodes"}}})
+		assert.NoError(t, err)
+		assert.True(t, served[ipfsPeers])
+		assert.True(t, served[PodMetricsResource])
+	})
+
+	t.Run("metrics not installed", func(t *testing.T) {
+		served, err := discover(resources{served: map[string][]string{ipfs: {"peers"}}})
+		assert.NoError(t, err)
+		assert.True(t, served[ipfsPeers])
+		assert.False(t, served[PodMetricsResource])
+	})
+
+	t.Run("metrics unavailable", func(t *testing.T) {
+		served, err := discover(resources{
+			served: map[string][]string{ipfs: {"peers"}},
+			errors: map[string]error{MetricsGroupVersion.String(): unavailable},
+		})
+		assert.NoError(t, err)
+		assert.True(t, served[ipfsPeers])
+		assert.False(t, served[PodMetricsResource])
+	})
+
+	t.Run("kotal group unavailable", func(t *testing.T) {
+		_, err := discover(resources{errors: map[string]error{ipfs: unavailable}})
+		assert.Error(t, err)
+	})
+}
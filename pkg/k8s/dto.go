@@ -1,24 +1,149 @@
 package k8s
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/go-playground/validator/v10"
 	restErrors "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/logger"
 	sharedAPI "github.com/kotalco/kotal/apis/shared"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"regexp"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// ProtectedPrefixes are the label and annotation key prefixes managed by kotal, users can't set them
+var ProtectedPrefixes = []string{"app.kubernetes.io/", "kotal.io/"}
+
+// labelsImmutable is the validation error of label updates, the kotal operator copies the labels of a resource
+// into the selector of its statefulset which can't be changed
+const labelsImmutable = "labels can't be changed after the resource is created"
+
 type MetaDataDto struct {
 	Name      string `json:"name" validate:"regexp,lt=64"`
 	Namespace string `json:"namespace,omitempty"`
+	// Labels and Annotations are the user defined ones, keys with protected prefixes are left out
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 func (metaDto *MetaDataDto) ObjectMetaFromMetadataDto() metav1.ObjectMeta {
 	return metav1.ObjectMeta{
-		Name:      metaDto.Name,
-		Namespace: metaDto.Namespace,
+		Name:        metaDto.Name,
+		Namespace:   metaDto.Namespace,
+		Labels:      merge(nil, metaDto.Labels),
+		Annotations: merge(nil, metaDto.Annotations),
+	}
+}
+
+// FromObjectMeta sets the name and the user defined labels and annotations from meta
+func (metaDto *MetaDataDto) FromObjectMeta(meta metav1.ObjectMeta) {
+	metaDto.Name = meta.Name
+	metaDto.Labels = userDefined(meta.Labels)
+	metaDto.Annotations = userDefined(meta.Annotations)
+}
+
+// UpdateObjectMeta replaces the user defined annotations of meta with the dto ones if they're set
+// annotations with protected prefixes are kept, labels are set on create only and are rejected if they're changed
+func (metaDto *MetaDataDto) UpdateObjectMeta(meta *metav1.ObjectMeta) restErrors.IRestErr {
+	if metaDto.Labels != nil && LabelsChanged(userDefined(meta.Labels), metaDto.Labels) {
+		return restErrors.NewValidationError(map[string]string{"labels": labelsImmutable})
+	}
+	if metaDto.Annotations != nil {
+		meta.Annotations = merge(protected(meta.Annotations), metaDto.Annotations)
+	}
+	return nil
+}
+
+// LabelsChanged reports whether labels differ from current, nil and empty labels are the same
+func LabelsChanged(current, labels map[string]string) bool {
+	if len(current) != len(labels) {
+		return true
+	}
+	for key, value := range labels {
+		if current[key] != value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsProtected reports whether key has a prefix managed by kotal
+func IsProtected(key string) bool {
+	for _, prefix := range ProtectedPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func userDefined(values map[string]string) map[string]string {
+	var result map[string]string
+	for key, value := range values {
+		if IsProtected(key) {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func protected(values map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, value := range values {
+		if IsProtected(key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// merge copies values into dst, dst is created if it's nil and values isn't empty
+func merge(dst, values map[string]string) map[string]string {
+	if dst == nil && len(values) > 0 {
+		dst = map[string]string{}
+	}
+	for key, value := range values {
+		dst[key] = value
+	}
+	return dst
+}
+
+// ValidateLabelsAndAnnotations validates the kubernetes syntax of labels and annotations, and that they don't use protected prefixes
+func (dto *MetaDataDto) ValidateLabelsAndAnnotations() restErrors.IRestErr {
+	fields := dto.labelsAndAnnotationsErrors()
+	if len(fields) > 0 {
+		return restErrors.NewValidationError(fields)
+	}
+	return nil
+}
+
+func (dto *MetaDataDto) labelsAndAnnotationsErrors() map[string]string {
+	fields := map[string]string{}
+	errs := map[string]field.ErrorList{
+		"labels":      metav1validation.ValidateLabels(dto.Labels, field.NewPath("labels")),
+		"annotations": apimachineryvalidation.ValidateAnnotations(dto.Annotations, field.NewPath("annotations")),
+	}
+	for name, values := range map[string]map[string]string{"labels": dto.Labels, "annotations": dto.Annotations} {
+		for key := range values {
+			if IsProtected(key) {
+				errs[name] = append(errs[name], field.Forbidden(field.NewPath(name).Key(key), fmt.Sprintf("keys prefixed with %s are managed by kotal", strings.Join(ProtectedPrefixes, " or "))))
+			}
+		}
+	}
+	for name, list := range errs {
+		if len(list) > 0 {
+			fields[name] = list.ToAggregate().Error()
+		}
 	}
+	return fields
 }
 
 func (dto *MetaDataDto) Validate() restErrors.IRestErr {
@@ -34,18 +159,18 @@ func (dto *MetaDataDto) Validate() restErrors.IRestErr {
 
 	err = newValidator.Struct(dto)
 
+	fields := dto.labelsAndAnnotationsErrors()
 	if err != nil {
-		fields := map[string]string{}
 		for _, err := range err.(validator.ValidationErrors) {
 			switch err.Field() {
 			case "Name":
 				fields["name"] = "name must start and end with an alphanumeric, and contains no more than 64 alphanumeric characters and - in total."
 			}
 		}
+	}
 
-		if len(fields) > 0 {
-			return restErrors.NewValidationError(fields)
-		}
+	if len(fields) > 0 {
+		return restErrors.NewValidationError(fields)
 	}
 
 	return nil
@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"net/http"
+	"testing"
+
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMetaDataDtoValidate(t *testing.T) {
+	dto := MetaDataDto{
+		Name:        "my-node",
+		Labels:      map[string]string{"team": "core", "example.com/env": "prod"},
+		Annotations: map[string]string{"cost-center": "1234 / infra"},
+	}
+	assert.Nil(t, dto.Validate())
+
+	dto.Labels = map[string]string{"team": "not a valid value", "kotal.io/protocol": "ethereum"}
+	dto.Annotations = map[string]string{"app.kubernetes.io/name": "geth"}
+	err := dto.Validate()
+	if assert.NotNil(t, err) {
+		validations := err.(restErrors.RestErr).Validations
+		assert.Contains(t, validations, "labels")
+		assert.Contains(t, validations, "annotations")
+		assert.NotContains(t, validations, "name")
+	}
+	assert.NotNil(t, dto.ValidateLabelsAndAnnotations())
+
+	dto.Labels = nil
+	dto.Annotations = nil
+	assert.Nil(t, dto.ValidateLabelsAndAnnotations())
+}
+
+func TestMetaDataDtoObjectMeta(t *testing.T) {
+	meta := metav1.ObjectMeta{
+		Name: "my-node",
+		Labels: map[string]string{
+			"team":                         "core",
+			"app.kubernetes.io/managed-by": "kotal-operator",
+		},
+		Annotations: map[string]string{"kotal.io/restarted-at": "now"},
+	}
+
+	dto := MetaDataDto{}
+	dto.FromObjectMeta(meta)
+	assert.Equal(t, "my-node", dto.Name)
+	assert.Equal(t, map[string]string{"team": "core"}, dto.Labels)
+	assert.Nil(t, dto.Annotations)
+
+	// unchanged labels are accepted and unset annotations are left as is
+	update := MetaDataDto{Labels: map[string]string{"team": "core"}}
+	assert.Nil(t, update.UpdateObjectMeta(&meta))
+	assert.Equal(t, map[string]string{"team": "core", "app.kubernetes.io/managed-by": "kotal-operator"}, meta.Labels)
+	assert.Equal(t, map[string]string{"kotal.io/restarted-at": "now"}, meta.Annotations)
+
+	// annotations are replaced and protected ones are kept
+	update = MetaDataDto{Annotations: map[string]string{"cost-center": "1234"}}
+	assert.Nil(t, update.UpdateObjectMeta(&meta))
+	assert.Equal(t, map[string]string{"cost-center": "1234", "kotal.io/restarted-at": "now"}, meta.Annotations)
+
+	// labels are copied by the operator into the immutable selector of the statefulset, they can't be changed
+	for _, labels := range []map[string]string{{"team": "infra"}, {"team": "core", "env": "prod"}, {}} {
+		update = MetaDataDto{Labels: labels, Annotations: map[string]string{"owner": "ops"}}
+		err := update.UpdateObjectMeta(&meta)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+			assert.Contains(t, err.(restErrors.RestErr).Validations, "labels")
+		}
+		assert.Equal(t, map[string]string{"team": "core", "app.kubernetes.io/managed-by": "kotal-operator"}, meta.Labels)
+		assert.Equal(t, map[string]string{"cost-center": "1234", "kotal.io/restarted-at": "now"}, meta.Annotations)
+	}
+}
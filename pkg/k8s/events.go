@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kotalco/community-api/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ownedKinds are the kinds of the objects the kotal operator creates for a resource, named after it
+var ownedKinds = map[string]bool{
+	"StatefulSet":           true,
+	"Service":               true,
+	"PersistentVolumeClaim": true,
+}
+
+// EventDto is a kubernetes event of a resource or of the objects the kotal operator created for it
+type EventDto struct {
+	Type      string `json:"type"`
+	Reason    string `json:"reason"`
+	Message   string `json:"message"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Source    string `json:"source,omitempty"`
+	Count     int32  `json:"count"`
+	FirstSeen string `json:"firstSeen"`
+	LastSeen  string `json:"lastSeen"`
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// eventKey identifies the duplicates of an event
+type eventKey struct {
+	kind, name, eventType, reason, message string
+}
+
+// IsResourceEvent reports whether event is about the resource of kind by name, its statefulset, pods, volume or service
+func IsResourceEvent(kind Kind, name string, event *corev1.Event) bool {
+	object := event.InvolvedObject
+	switch {
+	case object.Kind == kind.Kind && object.APIVersion == kind.GroupVersion().String():
+		return object.Name == name
+	case ownedKinds[object.Kind]:
+		return object.Name == name
+	case object.Kind == "Pod":
+		// statefulset pods are named after it with their ordinal
+		ordinal := strings.TrimPrefix(object.Name, name+"-")
+		return ordinal != object.Name && ordinal != "" && strings.Trim(ordinal, "0123456789") == ""
+	}
+	return false
+}
+
+// NewEventDto returns the dto of a kubernetes event
+func NewEventDto(event *corev1.Event) EventDto {
+	dto := EventDto{
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Kind:    event.InvolvedObject.Kind,
+		Name:    event.InvolvedObject.Name,
+		Source:  event.Source.Component,
+		Count:   event.Count,
+	}
+	if dto.Source == "" {
+		dto.Source = event.ReportingController
+	}
+
+	// events recorded with the events.k8s.io api set their event time and series instead of timestamps and count
+	dto.firstSeen, dto.lastSeen = event.FirstTimestamp.Time, event.LastTimestamp.Time
+	if dto.firstSeen.IsZero() {
+		dto.firstSeen = event.EventTime.Time
+	}
+	if dto.firstSeen.IsZero() {
+		dto.firstSeen = event.CreationTimestamp.Time
+	}
+	if series := event.Series; series != nil {
+		dto.Count, dto.lastSeen = series.Count, series.LastObservedTime.Time
+	}
+	if dto.lastSeen.IsZero() {
+		dto.lastSeen = dto.firstSeen
+	}
+	if dto.Count < 1 {
+		dto.Count = 1
+	}
+
+	dto.setTimes()
+	return dto
+}
+
+// NewEventDtos returns events de-duplicated and sorted by the time they were last seen, oldest first
+// events of the same object with the same type, reason and message are merged and their counts summed
+func NewEventDtos(events []corev1.Event) []EventDto {
+	dtos := make([]EventDto, 0, len(events))
+	merged := map[eventKey]int{}
+	for i := range events {
+		dto := NewEventDto(&events[i])
+		key := eventKey{dto.Kind, dto.Name, dto.Type, dto.Reason, dto.Message}
+		index, ok := merged[key]
+		if !ok {
+			merged[key] = len(dtos)
+			dtos = append(dtos, dto)
+			continue
+		}
+
+		previous := &dtos[index]
+		previous.Count += dto.Count
+		if dto.firstSeen.Before(previous.firstSeen) {
+			previous.firstSeen = dto.firstSeen
+		}
+		if dto.lastSeen.After(previous.lastSeen) {
+			previous.lastSeen, previous.Source = dto.lastSeen, dto.Source
+		}
+		previous.setTimes()
+	}
+
+	sort.SliceStable(dtos, func(i, j int) bool { return dtos[i].lastSeen.Before(dtos[j].lastSeen) })
+	return dtos
+}
+
+func (dto *EventDto) setTimes() {
+	dto.FirstSeen = dto.firstSeen.UTC().Format(shared.JavascriptISOString)
+	dto.LastSeen = dto.lastSeen.UTC().Format(shared.JavascriptISOString)
+}
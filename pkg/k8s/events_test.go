@@ -0,0 +1,71 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsResourceEvent(t *testing.T) {
+	kind, ok := KindFor(schema.GroupVersionKind{Group: "ethereum.kotal.io", Version: "v1alpha1", Kind: "Node"})
+	assert.True(t, ok)
+
+	tests := []struct {
+		name   string
+		object corev1.ObjectReference
+		match  bool
+	}{
+		{"resource", corev1.ObjectReference{APIVersion: "ethereum.kotal.io/v1alpha1", Kind: "Node", Name: "eth"}, true},
+		{"same name other kind", corev1.ObjectReference{APIVersion: "bitcoin.kotal.io/v1alpha1", Kind: "Node", Name: "eth"}, false},
+		{"statefulset", corev1.ObjectReference{Kind: "StatefulSet", Name: "eth"}, true},
+		{"volume", corev1.ObjectReference{Kind: "PersistentVolumeClaim", Name: "eth"}, true},
+		{"service", corev1.ObjectReference{Kind: "Service", Name: "eth"}, true},
+		{"pod", corev1.ObjectReference{Kind: "Pod", Name: "eth-0"}, true},
+		{"pod of another resource", corev1.ObjectReference{Kind: "Pod", Name: "eth-mainnet-0"}, false},
+		{"other statefulset", corev1.ObjectReference{Kind: "StatefulSet", Name: "eth-mainnet"}, false},
+		{"secret", corev1.ObjectReference{Kind: "Secret", Name: "eth"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.match, IsResourceEvent(kind, "eth", &corev1.Event{InvolvedObject: test.object}))
+		})
+	}
+}
+
+func TestNewEventDtos(t *testing.T) {
+	at := func(minutes int) metav1.Time {
+		return metav1.NewTime(time.Date(2023, 5, 20, 12, minutes, 0, 0, time.UTC))
+	}
+	pod := corev1.ObjectReference{Kind: "Pod", Name: "eth-0"}
+	events := []corev1.Event{
+		{
+			InvolvedObject: pod, Type: corev1.EventTypeWarning, Reason: "FailedScheduling", Message: "0/3 nodes are available",
+			Source: corev1.EventSource{Component: "default-scheduler"}, Count: 2, FirstTimestamp: at(1), LastTimestamp: at(5),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "StatefulSet", Name: "eth"}, Type: corev1.EventTypeNormal, Reason: "SuccessfulCreate", Message: "create Pod eth-0",
+			Source: corev1.EventSource{Component: "statefulset-controller"}, Count: 1, FirstTimestamp: at(0), LastTimestamp: at(0),
+		},
+		{
+			InvolvedObject: pod, Type: corev1.EventTypeWarning, Reason: "FailedScheduling", Message: "0/3 nodes are available",
+			ReportingController: "default-scheduler", EventTime: metav1.NewMicroTime(at(6).Time),
+			Series: &corev1.EventSeries{Count: 3, LastObservedTime: metav1.NewMicroTime(at(9).Time)},
+		},
+	}
+
+	dtos := NewEventDtos(events)
+	assert.Len(t, dtos, 2)
+	assert.Equal(t, "SuccessfulCreate", dtos[0].Reason)
+	assert.Equal(t, int32(1), dtos[0].Count)
+	assert.Equal(t, "2023-05-20T12:00:00Z", dtos[0].LastSeen)
+	assert.Equal(t, "FailedScheduling", dtos[1].Reason)
+	assert.Equal(t, int32(5), dtos[1].Count)
+	assert.Equal(t, "default-scheduler", dtos[1].Source)
+	assert.Equal(t, "2023-05-20T12:01:00Z", dtos[1].FirstSeen)
+	assert.Equal(t, "2023-05-20T12:09:00Z", dtos[1].LastSeen)
+}
@@ -0,0 +1,242 @@
+package k8s
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	aptosv1alpha1 "github.com/kotalco/kotal/apis/aptos/v1alpha1"
+	bitcoinv1alpha1 "github.com/kotalco/kotal/apis/bitcoin/v1alpha1"
+	chainlinkv1alpha1 "github.com/kotalco/kotal/apis/chainlink/v1alpha1"
+	ethereumv1alpha1 "github.com/kotalco/kotal/apis/ethereum/v1alpha1"
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	filecoinv1alpha1 "github.com/kotalco/kotal/apis/filecoin/v1alpha1"
+	ipfsv1alpha1 "github.com/kotalco/kotal/apis/ipfs/v1alpha1"
+	nearv1alpha1 "github.com/kotalco/kotal/apis/near/v1alpha1"
+	polkadotv1alpha1 "github.com/kotalco/kotal/apis/polkadot/v1alpha1"
+	stacksv1alpha1 "github.com/kotalco/kotal/apis/stacks/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Kind is a kubernetes kind the api server reads or writes
+type Kind struct {
+	// Protocol is the url group of the kind, empty for core kinds
+	Protocol string
+	// Resource is the plural resource name, it matches the resource url group of kotal kinds
+	Resource string
+	schema.GroupVersionKind
+	NewObject func() client.Object
+	NewList   func() client.ObjectList
+}
+
+// GroupVersionResource returns the resource of the kind
+func (k Kind) GroupVersionResource() schema.GroupVersionResource {
+	return k.GroupVersion().WithResource(k.Resource)
+}
+
+// IsServed reports whether the cluster serves the kind, core kinds are always served
+func (k Kind) IsServed() bool {
+	return k.Protocol == "" || Discovery().IsServed(k.GroupVersionResource())
+}
+
+// KotalKinds are the kinds of the kotal operator mapped by the api server
+var KotalKinds = []Kind{
+	{
+		Protocol:         "aptos",
+		Resource:         "nodes",
+		GroupVersionKind: aptosv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &aptosv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &aptosv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "bitcoin",
+		Resource:         "nodes",
+		GroupVersionKind: bitcoinv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &bitcoinv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &bitcoinv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "chainlink",
+		Resource:         "nodes",
+		GroupVersionKind: chainlinkv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &chainlinkv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &chainlinkv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "ethereum",
+		Resource:         "nodes",
+		GroupVersionKind: ethereumv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &ethereumv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &ethereumv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "ethereum2",
+		Resource:         "beaconnodes",
+		GroupVersionKind: ethereum2v1alpha1.GroupVersion.WithKind("BeaconNode"),
+		NewObject:        func() client.Object { return &ethereum2v1alpha1.BeaconNode{} },
+		NewList:          func() client.ObjectList { return &ethereum2v1alpha1.BeaconNodeList{} },
+	},
+	{
+		Protocol:         "ethereum2",
+		Resource:         "validators",
+		GroupVersionKind: ethereum2v1alpha1.GroupVersion.WithKind("Validator"),
+		NewObject:        func() client.Object { return &ethereum2v1alpha1.Validator{} },
+		NewList:          func() client.ObjectList { return &ethereum2v1alpha1.ValidatorList{} },
+	},
+	{
+		Protocol:         "filecoin",
+		Resource:         "nodes",
+		GroupVersionKind: filecoinv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &filecoinv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &filecoinv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "ipfs",
+		Resource:         "peers",
+		GroupVersionKind: ipfsv1alpha1.GroupVersion.WithKind("Peer"),
+		NewObject:        func() client.Object { return &ipfsv1alpha1.Peer{} },
+		NewList:          func() client.ObjectList { return &ipfsv1alpha1.PeerList{} },
+	},
+	{
+		Protocol:         "ipfs",
+		Resource:         "clusterpeers",
+		GroupVersionKind: ipfsv1alpha1.GroupVersion.WithKind("ClusterPeer"),
+		NewObject:        func() client.Object { return &ipfsv1alpha1.ClusterPeer{} },
+		NewList:          func() client.ObjectList { return &ipfsv1alpha1.ClusterPeerList{} },
+	},
+	{
+		Protocol:         "near",
+		Resource:         "nodes",
+		GroupVersionKind: nearv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &nearv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &nearv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "polkadot",
+		Resource:         "nodes",
+		GroupVersionKind: polkadotv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &polkadotv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &polkadotv1alpha1.NodeList{} },
+	},
+	{
+		Protocol:         "stacks",
+		Resource:         "nodes",
+		GroupVersionKind: stacksv1alpha1.GroupVersion.WithKind("Node"),
+		NewObject:        func() client.Object { return &stacksv1alpha1.Node{} },
+		NewList:          func() client.ObjectList { return &stacksv1alpha1.NodeList{} },
+	},
+}
+
+// GeneratedKinds are the core kinds the kotal operator generates for its resources, named after the resource
+// secrets are created by the api server itself
+var GeneratedKinds = []Kind{
+	{
+		Resource:         "statefulsets",
+		GroupVersionKind: appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+		NewObject:        func() client.Object { return &appsv1.StatefulSet{} },
+		NewList:          func() client.ObjectList { return &appsv1.StatefulSetList{} },
+	},
+	{
+		Resource:         "services",
+		GroupVersionKind: corev1.SchemeGroupVersion.WithKind("Service"),
+		NewObject:        func() client.Object { return &corev1.Service{} },
+		NewList:          func() client.ObjectList { return &corev1.ServiceList{} },
+	},
+	{
+		Resource:         "configmaps",
+		GroupVersionKind: corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+		NewObject:        func() client.Object { return &corev1.ConfigMap{} },
+		NewList:          func() client.ObjectList { return &corev1.ConfigMapList{} },
+	},
+	{
+		Resource:         "secrets",
+		GroupVersionKind: corev1.SchemeGroupVersion.WithKind("Secret"),
+		NewObject:        func() client.Object { return &corev1.Secret{} },
+		NewList:          func() client.ObjectList { return &corev1.SecretList{} },
+	},
+}
+
+// KindOf returns the kotal or generated kind of obj
+func KindOf(obj client.Object) (Kind, bool) {
+	for _, kinds := range [][]Kind{KotalKinds, GeneratedKinds} {
+		for _, kind := range kinds {
+			if reflect.TypeOf(kind.NewObject()) == reflect.TypeOf(obj) {
+				return kind, true
+			}
+		}
+	}
+	return Kind{}, false
+}
+
+// KindFor returns the kotal or generated kind of gvk
+func KindFor(gvk schema.GroupVersionKind) (Kind, bool) {
+	for _, kinds := range [][]Kind{KotalKinds, GeneratedKinds} {
+		for _, kind := range kinds {
+			if kind.GroupVersionKind == gvk {
+				return kind, true
+			}
+		}
+	}
+	return Kind{}, false
+}
+
+// KindObjects are the objects of a kind
+type KindObjects struct {
+	Kind    Kind
+	Objects []client.Object
+}
+
+// ListKotalKinds lists the objects of every kotal kind the cluster serves in namespace concurrently
+// kinds are returned in the order of KotalKinds
+func ListKotalKinds(ctx context.Context, namespace string, opts ...client.ListOption) ([]KindObjects, error) {
+	kinds := make([]Kind, 0, len(KotalKinds))
+	for _, kind := range KotalKinds {
+		if kind.IsServed() {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	results := make([]KindObjects, len(kinds))
+	errs := make([]error, len(kinds))
+	opts = append(opts, client.InNamespace(namespace))
+
+	var wg sync.WaitGroup
+	for i, kind := range kinds {
+		wg.Add(1)
+		go func(i int, kind Kind) {
+			defer wg.Done()
+			results[i], errs[i] = listKind(ctx, kind, opts)
+		}(i, kind)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func listKind(ctx context.Context, kind Kind, opts []client.ListOption) (KindObjects, error) {
+	list := kind.NewList()
+	if err := NewClientService().List(ctx, list, opts...); err != nil {
+		return KindObjects{}, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return KindObjects{}, err
+	}
+
+	result := KindObjects{Kind: kind, Objects: make([]client.Object, 0, len(items))}
+	for _, item := range items {
+		if obj, ok := item.(client.Object); ok {
+			result.Objects = append(result.Objects, obj)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,175 @@
+package k8s
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kotalco/community-api/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// log streams of a container, the logs of the current container or of the previous one terminated before it restarted
+const (
+	LogStreamCurrent  = "current"
+	LogStreamPrevious = "previous"
+)
+
+// logLevels ranks the log levels, aliases used by the clients rank with the level they stand for
+var logLevels = map[string]int{
+	"trace":    0,
+	"trce":     0,
+	"debug":    1,
+	"dbug":     1,
+	"info":     2,
+	"notice":   2,
+	"warn":     3,
+	"warning":  3,
+	"error":    4,
+	"eror":     4,
+	"err":      4,
+	"crit":     5,
+	"critical": 5,
+	"fatal":    5,
+	"panic":    5,
+}
+
+var (
+	// keyLevel matches levels logged as a key, like level=info or "level":"info"
+	keyLevel = regexp.MustCompile(`(?i)\b(?:level|lvl|severity)"?\s*[=:]\s*"?([a-z]+)`)
+	// prefixLevel matches upper case levels at the start of lines, after their timestamp if any
+	prefixLevel = regexp.MustCompile(`\b(TRACE|TRCE|DEBUG|DBUG|INFO|NOTICE|WARN|WARNING|ERROR|EROR|ERR|CRIT|CRITICAL|FATAL|PANIC)\b`)
+)
+
+// prefixLevelLength is the length of the start of lines searched for upper case levels
+const prefixLevelLength = 64
+
+// LogOptions are the options of the logs of a container
+type LogOptions struct {
+	Container    string
+	TailLines    int64
+	SinceSeconds *int64
+	SinceTime    *metav1.Time
+	Timestamps   bool
+	Previous     bool
+	// Filter keeps the lines matching it if it's not nil
+	Filter *regexp.Regexp
+	// Level keeps the lines logged at this level or above if it's not empty
+	Level string
+}
+
+// LogLineDto is a complete line of the logs of a container
+type LogLineDto struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Stream    string `json:"stream"`
+	Container string `json:"container,omitempty"`
+	Level     string `json:"level,omitempty"`
+	Message   string `json:"message"`
+}
+
+// IsLogLevel reports whether level is a known log level
+func IsLogLevel(level string) bool {
+	_, ok := logLevels[strings.ToLower(level)]
+	return ok
+}
+
+// PodLogOptions returns the kubernetes options of the logs, timestamps are always requested to be parsed from the lines
+// logs of the current container are followed, the previous container is terminated
+func (opts LogOptions) PodLogOptions() *corev1.PodLogOptions {
+	tailLines := opts.TailLines
+	return &corev1.PodLogOptions{
+		Container:    opts.Container,
+		Follow:       !opts.Previous,
+		Previous:     opts.Previous,
+		TailLines:    &tailLines,
+		SinceSeconds: opts.SinceSeconds,
+		SinceTime:    opts.SinceTime,
+		Timestamps:   true,
+	}
+}
+
+// LogFilter parses the raw lines of a log stream and keeps the lines matching the filter and level of its options
+// lines without a level, like the lines of a stack trace, have the level of the line before them
+type LogFilter struct {
+	opts      LogOptions
+	lastLevel string
+}
+
+// NewLogFilter returns the filter of the lines of the logs requested with opts
+func NewLogFilter(opts LogOptions) *LogFilter {
+	return &LogFilter{opts: opts}
+}
+
+// Line returns the dto of a raw line timestamped by kubernetes and whether it's kept
+func (f *LogFilter) Line(raw string) (LogLineDto, bool) {
+	line := LogLineDto{Stream: LogStreamCurrent, Container: f.opts.Container, Message: strings.TrimRight(raw, "\r\n")}
+	if f.opts.Previous {
+		line.Stream = LogStreamPrevious
+	}
+
+	if timestamp, message, ok := strings.Cut(line.Message, " "); ok {
+		if at, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			line.Message = message
+			if f.opts.Timestamps {
+				line.Timestamp = at.UTC().Format(shared.JavascriptISOString)
+			}
+		}
+	}
+
+	line.Level = LogLevel(line.Message)
+	if line.Level == "" {
+		line.Level = f.lastLevel
+	}
+	f.lastLevel = line.Level
+
+	if f.opts.Filter != nil && !f.opts.Filter.MatchString(line.Message) {
+		return line, false
+	}
+	if f.opts.Level != "" {
+		rank, ok := logLevels[line.Level]
+		if !ok || rank < logLevels[strings.ToLower(f.opts.Level)] {
+			return line, false
+		}
+	}
+	return line, true
+}
+
+// LogLevel returns the level of a log line in lower case, or an empty string if it has none
+// aliases like EROR are returned as the level they stand for
+func LogLevel(message string) string {
+	if match := keyLevel.FindStringSubmatch(message); match != nil {
+		if level := normalizeLevel(match[1]); level != "" {
+			return level
+		}
+	}
+
+	prefix := message
+	if len(prefix) > prefixLevelLength {
+		prefix = prefix[:prefixLevelLength]
+	}
+	if match := prefixLevel.FindString(prefix); match != "" {
+		return normalizeLevel(match)
+	}
+	return ""
+}
+
+// normalizeLevel returns the canonical name of a level and its aliases
+func normalizeLevel(level string) string {
+	switch rank, ok := logLevels[strings.ToLower(level)]; {
+	case !ok:
+		return ""
+	case rank == 0:
+		return "trace"
+	case rank == 1:
+		return "debug"
+	case rank == 2:
+		return "info"
+	case rank == 3:
+		return "warn"
+	case rank == 4:
+		return "error"
+	default:
+		return "fatal"
+	}
+}
@@ -0,0 +1,80 @@
+package k8s
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLevel(t *testing.T) {
+	tests := []struct {
+		message string
+		level   string
+	}{
+		{"INFO [05-20|12:00:00.000] Imported new chain segment", "info"},
+		{"WARN [05-20|12:00:00.000] Beacon client online, but no consensus updates received", "warn"},
+		{"EROR [05-20|12:00:00.000] Failed to open database", "error"},
+		{"CRIT [05-20|12:00:00.000] Unable to start node", "fatal"},
+		{`time="2023-05-20T12:00:00Z" level=warning msg="Peer disconnected" prefix=p2p`, "warn"},
+		{`{"level":"debug","msg":"dialing peer"}`, "debug"},
+		{"May 20 12:00:00.000 INFO Synced, slot: 6500000", "info"},
+		{"goroutine 1 [running]:", ""},
+		{"	/go/src/main.go:12 +0x1d", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			assert.Equal(t, test.level, LogLevel(test.message))
+		})
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	t.Run("timestamps", func(t *testing.T) {
+		filter := NewLogFilter(LogOptions{Container: "node", Timestamps: true})
+		line, ok := filter.Line("2023-05-20T12:00:00.123456789Z INFO [05-20|12:00:00.123] Imported new chain segment\n")
+		assert.True(t, ok)
+		assert.Equal(t, LogLineDto{
+			Timestamp: "2023-05-20T12:00:00.123Z",
+			Stream:    LogStreamCurrent,
+			Container: "node",
+			Level:     "info",
+			Message:   "INFO [05-20|12:00:00.123] Imported new chain segment",
+		}, line)
+	})
+
+	t.Run("without timestamps", func(t *testing.T) {
+		filter := NewLogFilter(LogOptions{Previous: true})
+		line, ok := filter.Line("2023-05-20T12:00:00Z panic: runtime error")
+		assert.True(t, ok)
+		assert.Equal(t, "", line.Timestamp)
+		assert.Equal(t, LogStreamPrevious, line.Stream)
+		assert.Equal(t, "panic: runtime error", line.Message)
+	})
+
+	t.Run("level", func(t *testing.T) {
+		filter := NewLogFilter(LogOptions{Level: "warn"})
+		lines := []string{
+			"2023-05-20T12:00:00Z INFO Imported new chain segment",
+			"2023-05-20T12:00:01Z EROR Failed to open database",
+			"2023-05-20T12:00:01Z goroutine 1 [running]:",
+			"2023-05-20T12:00:02Z INFO Looking for peers",
+		}
+		kept := make([]string, 0)
+		for _, raw := range lines {
+			if line, ok := filter.Line(raw); ok {
+				kept = append(kept, line.Message)
+			}
+		}
+		assert.Equal(t, []string{"EROR Failed to open database", "goroutine 1 [running]:"}, kept)
+	})
+
+	t.Run("regex", func(t *testing.T) {
+		filter := NewLogFilter(LogOptions{Filter: regexp.MustCompile(`peers?`)})
+		_, ok := filter.Line("2023-05-20T12:00:00Z INFO Imported new chain segment")
+		assert.False(t, ok)
+		_, ok = filter.Line("2023-05-20T12:00:02Z INFO Looking for peers")
+		assert.True(t, ok)
+	})
+}
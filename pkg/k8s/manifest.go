@@ -0,0 +1,185 @@
+package k8s
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// serverManagedMetadata are the metadata fields set by the api server, they're stripped from manifests
+var serverManagedMetadata = []string{
+	"namespace",
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+	"selfLink",
+	"managedFields",
+	"ownerReferences",
+	"finalizers",
+}
+
+// identitySecretFields are the fields referencing secrets holding the keys identifying a node or a validator on its network
+// nodes sharing them collide on the network, validators sharing them double sign
+var identitySecretFields = []string{"nodePrivateKeySecretName", "privateKeySecretName", "validatorSecretName", "seedPrivateKeySecretName", "secretName"}
+
+// derivedFields are the sibling fields derived from the key of an identity secret field, removed with it
+var derivedFields = map[string][]string{
+	// ipfs cluster peer id is derived from its private key
+	"privateKeySecretName": {"id"},
+}
+
+// lastAppliedAnnotation is the previous configuration recorded by kubectl apply
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Manifest returns obj of kind as a clean manifest ready to be applied to any namespace
+// status, server managed metadata and the namespace are stripped
+func Manifest(kind Kind, obj client.Object) (map[string]interface{}, error) {
+	manifest, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest["apiVersion"] = kind.GroupVersion().String()
+	manifest["kind"] = kind.Kind
+	CleanManifest(manifest)
+
+	return manifest, nil
+}
+
+// CleanManifest strips status, server managed metadata and the namespace from manifest
+func CleanManifest(manifest map[string]interface{}) {
+	delete(manifest, "status")
+
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	for _, field := range serverManagedMetadata {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, lastAppliedAnnotation)
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+}
+
+// ParseManifests returns the manifests of a yaml or json document stream
+// empty documents are ignored and the items of kubernetes lists are returned as manifests
+func ParseManifests(body []byte) ([]map[string]interface{}, error) {
+	manifests := make([]map[string]interface{}, 0)
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(body)))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		manifest := map[string]interface{}{}
+		if err = yaml.Unmarshal(doc, &manifest); err != nil {
+			return nil, fmt.Errorf("document %d: %w", len(manifests)+1, err)
+		}
+		if len(manifest) == 0 {
+			continue
+		}
+
+		if manifest["apiVersion"] != "v1" || manifest["kind"] != "List" {
+			manifests = append(manifests, manifest)
+			continue
+		}
+		items, _ := manifest["items"].([]interface{})
+		for _, item := range items {
+			if item, ok := item.(map[string]interface{}); ok {
+				manifests = append(manifests, item)
+			}
+		}
+	}
+}
+
+// ReferencedSecrets returns the sorted names of the secrets referenced by the spec of a manifest
+// secrets are referenced by fields named secretName, ending with SecretName or by walletPasswordSecret of validators
+func ReferencedSecrets(manifest map[string]interface{}) []string {
+	names := map[string]bool{}
+	collectSecrets(manifest["spec"], names)
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func collectSecrets(value interface{}, names map[string]bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if name, ok := field.(string); ok && name != "" && isSecretReference(key) {
+				names[name] = true
+				continue
+			}
+			collectSecrets(field, names)
+		}
+	case []interface{}:
+		for _, item := range value {
+			collectSecrets(item, names)
+		}
+	}
+}
+
+// IsIdentitySecret reports whether field references a secret holding the keys identifying a node or a validator
+func IsIdentitySecret(field string) bool {
+	for _, identity := range identitySecretFields {
+		if field == identity {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceSecrets replaces the secret references of the spec of a manifest by the name replace returns for them
+// references replaced by an empty name are removed with the fields derived from them
+func ReplaceSecrets(manifest map[string]interface{}, replace func(field, secret string) string) {
+	replaceSecrets(manifest["spec"], replace)
+}
+
+func replaceSecrets(value interface{}, replace func(field, secret string) string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			name, ok := field.(string)
+			if !ok || name == "" || !isSecretReference(key) {
+				replaceSecrets(field, replace)
+				continue
+			}
+			if replaced := replace(key, name); replaced != "" {
+				value[key] = replaced
+				continue
+			}
+			delete(value, key)
+			for _, derived := range derivedFields[key] {
+				delete(value, derived)
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			replaceSecrets(item, replace)
+		}
+	}
+}
+
+func isSecretReference(field string) bool {
+	return field == "secretName" || field == "walletPasswordSecret" || strings.HasSuffix(field, "SecretName")
+}
@@ -0,0 +1,101 @@
+package k8s
+
+import (
+	"testing"
+
+	ethereum2v1alpha1 "github.com/kotalco/kotal/apis/ethereum2/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestManifest(t *testing.T) {
+	validator := &ethereum2v1alpha1.Validator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-validator",
+			Namespace:         "default",
+			UID:               "1234",
+			ResourceVersion:   "42",
+			CreationTimestamp: metav1.Now(),
+			Labels:            map[string]string{"team": "core"},
+			Annotations:       map[string]string{lastAppliedAnnotation: "{}"},
+		},
+		Spec: ethereum2v1alpha1.ValidatorSpec{
+			Network:              "mainnet",
+			CertSecretName:       "beacon-cert",
+			WalletPasswordSecret: "wallet-password",
+			Keystores: []ethereum2v1alpha1.Keystore{
+				{SecretName: "keystore-1"},
+				{SecretName: "keystore-0"},
+			},
+		},
+	}
+	kind, _ := KindOf(validator)
+
+	manifest, err := Manifest(kind, validator)
+	assert.Nil(t, err)
+	assert.Equal(t, "ethereum2.kotal.io/v1alpha1", manifest["apiVersion"])
+	assert.Equal(t, "Validator", manifest["kind"])
+	assert.NotContains(t, manifest, "status")
+
+	metadata := manifest["metadata"].(map[string]interface{})
+	assert.Equal(t, "my-validator", metadata["name"])
+	assert.Equal(t, map[string]interface{}{"team": "core"}, metadata["labels"])
+	for _, field := range []string{"namespace", "uid", "resourceVersion", "creationTimestamp", "annotations"} {
+		assert.NotContains(t, metadata, field)
+	}
+
+	assert.Equal(t, []string{"beacon-cert", "keystore-0", "keystore-1", "wallet-password"}, ReferencedSecrets(manifest))
+}
+
+func TestParseManifests(t *testing.T) {
+	body := []byte(`# exported manifests
+apiVersion: ethereum.kotal.io/v1alpha1
+kind: Node
+metadata:
+  name: my-node
+spec:
+  network: goerli
+---
+---
+{"apiVersion": "v1", "kind": "List", "items": [{"apiVersion": "ipfs.kotal.io/v1alpha1", "kind": "Peer", "metadata": {"name": "my-peer"}}]}
+`)
+
+	manifests, err := ParseManifests(body)
+	assert.Nil(t, err)
+	if assert.Len(t, manifests, 2) {
+		assert.Equal(t, "Node", manifests[0]["kind"])
+		assert.Equal(t, "Peer", manifests[1]["kind"])
+	}
+
+	_, err = ParseManifests([]byte("kind: [Node"))
+	assert.NotNil(t, err)
+}
+
+func TestReplaceSecrets(t *testing.T) {
+	manifest := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"id":                   "12D3KooW",
+			"privateKeySecretName": "peer-key",
+			"clusterSecretName":    "cluster-secret",
+			"keystores": []interface{}{
+				map[string]interface{}{"secretName": "keystore-0"},
+			},
+		},
+	}
+
+	ReplaceSecrets(manifest, func(field, secret string) string {
+		if IsIdentitySecret(field) && secret == "keystore-0" {
+			return "keystore-1"
+		}
+		if IsIdentitySecret(field) {
+			return ""
+		}
+		return secret
+	})
+
+	spec := manifest["spec"].(map[string]interface{})
+	assert.NotContains(t, spec, "privateKeySecretName")
+	assert.NotContains(t, spec, "id")
+	assert.Equal(t, "cluster-secret", spec["clusterSecretName"])
+	assert.Equal(t, []string{"cluster-secret", "keystore-1"}, ReferencedSecrets(manifest))
+}
@@ -7,18 +7,21 @@ import (
 )
 
 var metricsClientset *metrics.Clientset
-var metricsClientsetOnce sync.Once
+var metricsClientsetLock = &sync.Mutex{}
 
 // MetricsClientset create k8s metrics client once
+// returns nil if the client can't be created, metrics are an optional capability
 func MetricsClientset() *metrics.Clientset {
 	var err error
-	metricsClientsetOnce.Do(func() {
+	metricsClientsetLock.Lock()
+	defer metricsClientsetLock.Unlock()
+
+	if metricsClientset == nil {
 		metricsClientset, err = NewMetricsClientset()
 		if err != nil {
-			// TODO: Don't panic
-			panic(err)
+			k8sLogger.Warn("K8S_METRICS_CLIENT_SET", err)
 		}
-	})
+	}
 	return metricsClientset
 }
 
@@ -28,6 +31,5 @@ func NewMetricsClientset() (*metrics.Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
-
 	return metrics.NewForConfig(config)
 }
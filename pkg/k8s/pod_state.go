@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// states of a resource computed from its pod
+const (
+	StateRunning = "running"
+	StatePending = "pending"
+	StateError   = "error"
+)
+
+// errorReasons are container waiting reasons the kubelet doesn't recover from without a change
+var errorReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"RunContainerError":          true,
+}
+
+// PodState returns the state of a resource from its pod and the reason it isn't running
+// a resource without a pod is pending
+func PodState(pod *corev1.Pod) (state string, reason string) {
+	if pod == nil {
+		return StatePending, "PodNotFound"
+	}
+	if pod.DeletionTimestamp != nil {
+		return StatePending, "Terminating"
+	}
+	if pod.Status.Phase == corev1.PodFailed {
+		if pod.Status.Reason != "" {
+			return StateError, pod.Status.Reason
+		}
+		return StateError, string(corev1.PodFailed)
+	}
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil && errorReasons[waiting.Reason] {
+			return StateError, waiting.Reason
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			if terminated.Reason != "" {
+				return StateError, terminated.Reason
+			}
+			return StateError, "Error"
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodRunning {
+		for _, status := range pod.Status.ContainerStatuses {
+			if !status.Ready {
+				return StatePending, "ContainersNotReady"
+			}
+		}
+		return StateRunning, ""
+	}
+
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" {
+			return StatePending, waiting.Reason
+		}
+	}
+	return StatePending, string(pod.Status.Phase)
+}
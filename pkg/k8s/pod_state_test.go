@@ -0,0 +1,52 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodState(t *testing.T) {
+	now := metav1.Now()
+
+	tests := []struct {
+		name   string
+		pod    *corev1.Pod
+		state  string
+		reason string
+	}{
+		{"no pod", nil, StatePending, "PodNotFound"},
+		{"terminating", &corev1.Pod{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}}, StatePending, "Terminating"},
+		{"failed", &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"}}, StateError, "Evicted"},
+		{"crash loop", &corev1.Pod{Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			},
+		}}, StateError, "CrashLoopBackOff"},
+		{"creating", &corev1.Pod{Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+			},
+		}}, StatePending, "ContainerCreating"},
+		{"not ready", &corev1.Pod{Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Ready: false}},
+		}}, StatePending, "ContainersNotReady"},
+		{"running", &corev1.Pod{Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Ready: true}},
+		}}, StateRunning, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, reason := PodState(test.pod)
+			assert.Equal(t, test.state, state)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
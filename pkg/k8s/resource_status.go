@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"time"
+
+	"github.com/kotalco/community-api/pkg/shared"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// StatusDto is the status of a resource computed from its statefulset and pod
+type StatusDto struct {
+	Phase                 string `json:"phase"`
+	Reason                string `json:"reason,omitempty"`
+	Ready                 bool   `json:"ready"`
+	Restarts              int32  `json:"restarts"`
+	LastTerminationReason string `json:"lastTerminationReason,omitempty"`
+	Image                 string `json:"image,omitempty"`
+	Node                  string `json:"node,omitempty"`
+	StartedAt             string `json:"startedAt,omitempty"`
+	Age                   string `json:"age,omitempty"`
+}
+
+// Statuses are the statuses of resources by name
+type Statuses map[string]StatusDto
+
+// Of returns the status of the resource by name, resources missing from statuses have no pod yet
+func (s Statuses) Of(name string) StatusDto {
+	if status, ok := s[name]; ok {
+		return status
+	}
+	return NewStatusDto(nil, time.Now())
+}
+
+// NewStatusDto returns the status of a resource from its pod
+func NewStatusDto(pod *corev1.Pod, now time.Time) StatusDto {
+	status := StatusDto{}
+	status.Phase, status.Reason = PodState(pod)
+	if pod == nil {
+		return status
+	}
+
+	status.Node = pod.Spec.NodeName
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			status.Ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	// the first container of kotal pods runs the node, the others are sidecars
+	main := ""
+	if len(pod.Spec.Containers) > 0 {
+		main = pod.Spec.Containers[0].Name
+	}
+	for _, container := range pod.Status.ContainerStatuses {
+		status.Restarts += container.RestartCount
+		if container.Name == main {
+			status.Image = container.Image
+		}
+		if status.LastTerminationReason != "" {
+			continue
+		}
+		if terminated := container.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			status.LastTerminationReason = terminated.Reason
+		} else if terminated = container.LastTerminationState.Terminated; terminated != nil {
+			status.LastTerminationReason = terminated.Reason
+		}
+	}
+
+	if start := pod.Status.StartTime; start != nil {
+		status.StartedAt = start.UTC().Format(shared.JavascriptISOString)
+		status.Age = duration.HumanDuration(now.Sub(start.Time))
+	}
+	return status
+}
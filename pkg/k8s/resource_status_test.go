@@ -0,0 +1,69 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewStatusDto(t *testing.T) {
+	now := time.Date(2023, 5, 20, 12, 0, 0, 0, time.UTC)
+	started := metav1.NewTime(now.Add(-26 * time.Hour))
+	running := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			NodeName:   "worker-1",
+			Containers: []corev1.Container{{Name: "node"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			StartTime:  &started,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "sidecar", Image: "busybox", Ready: true, RestartCount: 1},
+				{
+					Name:                 "node",
+					Image:                "ethereum/client-go:v1.11.6",
+					Ready:                true,
+					RestartCount:         2,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+				},
+			},
+		},
+	}
+
+	t.Run("no pod", func(t *testing.T) {
+		assert.Equal(t, StatusDto{Phase: StatePending, Reason: "PodNotFound"}, NewStatusDto(nil, now))
+	})
+
+	t.Run("running", func(t *testing.T) {
+		assert.Equal(t, StatusDto{
+			Phase:                 StateRunning,
+			Ready:                 true,
+			Restarts:              3,
+			LastTerminationReason: "OOMKilled",
+			Image:                 "ethereum/client-go:v1.11.6",
+			Node:                  "worker-1",
+			StartedAt:             "2023-05-19T10:00:00Z",
+			Age:                   "26h",
+		}, NewStatusDto(running, now))
+	})
+
+	t.Run("crashed", func(t *testing.T) {
+		pod := running.DeepCopy()
+		pod.Status.Conditions[0].Status = corev1.ConditionFalse
+		pod.Status.ContainerStatuses[1].State = corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}
+		status := NewStatusDto(pod, now)
+		assert.Equal(t, StateError, status.Phase)
+		assert.False(t, status.Ready)
+		assert.Equal(t, "Error", status.LastTerminationReason)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		statuses := Statuses{"running": NewStatusDto(running, now)}
+		assert.Equal(t, StateRunning, statuses.Of("running").Phase)
+		assert.Equal(t, "PodNotFound", statuses.Of("missing").Reason)
+	})
+}
@@ -3,18 +3,33 @@ package statefulset
 import (
 	"context"
 	"fmt"
+	"time"
+
 	restError "github.com/kotalco/community-api/pkg/errors"
 	"github.com/kotalco/community-api/pkg/k8s"
 	"github.com/kotalco/community-api/pkg/logger"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// labels set by the kotal operator on the statefulsets and pods of the resources
+const (
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedBy      = "kotal-operator"
+	instanceLabel  = "app.kubernetes.io/instance"
 )
 
 var k8sClient = k8s.NewClientService()
 
+// IStatefulSet reads the statefulsets of the kotal resources, they're owned by the kotal operator
+// which rewrites their spec on every reconcile, so changes made to them are reverted
 type IStatefulSet interface {
-	Get(namespacedName types.NamespacedName) (*appsv1.StatefulSet, restError.IRestErr)
+	Get(ctx context.Context, namespacedName types.NamespacedName) (*appsv1.StatefulSet, restError.IRestErr)
+	Status(ctx context.Context, namespacedName types.NamespacedName) (k8s.StatusDto, restError.IRestErr)
+	Statuses(ctx context.Context, namespace string) (k8s.Statuses, restError.IRestErr)
 }
 
 type statefulset struct {
@@ -24,16 +39,59 @@ func NewService() IStatefulSet {
 	return &statefulset{}
 }
 
-func (s *statefulset) Get(namespacedName types.NamespacedName) (*appsv1.StatefulSet, restError.IRestErr) {
+func (s *statefulset) Get(ctx context.Context, namespacedName types.NamespacedName) (*appsv1.StatefulSet, restError.IRestErr) {
 	record := &appsv1.StatefulSet{}
 
-	err := k8sClient.Get(context.Background(), namespacedName, record)
+	err := k8sClient.Get(ctx, namespacedName, record)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, restError.NewNotFoundError(fmt.Sprintf("record with the name %s doesn't exist", namespacedName.Name))
 		}
-		go logger.Error(s.Get, err)
+		logger.ErrorContext(ctx, s.Get, err)
 		return nil, restError.NewInternalServerError("can't list stateful set")
 	}
 	return record, nil
 }
+
+// Status returns the status of a resource computed from its statefulset and pod
+func (s *statefulset) Status(ctx context.Context, namespacedName types.NamespacedName) (k8s.StatusDto, restError.IRestErr) {
+	pods := &corev1.PodList{}
+	if err := k8sClient.List(ctx, pods, client.InNamespace(namespacedName.Namespace), client.MatchingLabels{managedByLabel: managedBy, instanceLabel: namespacedName.Name}); err != nil {
+		logger.ErrorContext(ctx, s.Status, err)
+		return k8s.StatusDto{}, restError.NewInternalServerError(fmt.Sprintf("can't get %s status", namespacedName.Name))
+	}
+
+	var pod *corev1.Pod
+	if len(pods.Items) > 0 {
+		pod = &pods.Items[0]
+	}
+	return k8s.NewStatusDto(pod, time.Now()), nil
+}
+
+// Statuses returns the statuses of the kotal resources in namespace by name
+// resources without a statefulset are missing, their status is pending
+func (s *statefulset) Statuses(ctx context.Context, namespace string) (k8s.Statuses, restError.IRestErr) {
+	list := &appsv1.StatefulSetList{}
+	if err := k8sClient.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{managedByLabel: managedBy}); err != nil {
+		logger.ErrorContext(ctx, s.Statuses, err)
+		return nil, restError.NewInternalServerError("can't list stateful sets")
+	}
+	pods := &corev1.PodList{}
+	if err := k8sClient.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{managedByLabel: managedBy}); err != nil {
+		logger.ErrorContext(ctx, s.Statuses, err)
+		return nil, restError.NewInternalServerError("can't list pods")
+	}
+
+	podsByInstance := map[string]*corev1.Pod{}
+	for i := range pods.Items {
+		podsByInstance[pods.Items[i].Labels[instanceLabel]] = &pods.Items[i]
+	}
+
+	now := time.Now()
+	statuses := k8s.Statuses{}
+	for i := range list.Items {
+		name := list.Items[i].Name
+		statuses[name] = k8s.NewStatusDto(podsByInstance[name], now)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,92 @@
+package statefulset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kotalco/community-api/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// readOnlyClient is a fake client failing the test on writes, the kotal operator owns the statefulsets
+// and their pods and reverts any change made to them
+type readOnlyClient struct {
+	client.Client
+	t *testing.T
+}
+
+func (c readOnlyClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.t.Errorf("%s is created", obj.GetName())
+	return nil
+}
+
+func (c readOnlyClient) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	c.t.Errorf("%s is updated", obj.GetName())
+	return nil
+}
+
+func (c readOnlyClient) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	c.t.Errorf("%s is patched", obj.GetName())
+	return nil
+}
+
+func (c readOnlyClient) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.t.Errorf("%s is deleted", obj.GetName())
+	return nil
+}
+
+func (c readOnlyClient) DeleteAllOf(_ context.Context, obj client.Object, _ ...client.DeleteAllOfOption) error {
+	c.t.Errorf("%T are deleted", obj)
+	return nil
+}
+
+func newClient(t *testing.T, objects ...client.Object) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, k8s.AddToScheme(scheme))
+	k8sClient = readOnlyClient{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(), t: t}
+}
+
+func meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "default",
+		Labels:    map[string]string{managedByLabel: managedBy, instanceLabel: "my-node"},
+	}
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+	name := types.NamespacedName{Namespace: "default", Name: "my-node"}
+	running := &corev1.Pod{ObjectMeta: meta("my-node-0"), Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+
+	t.Run("running", func(t *testing.T) {
+		newClient(t, &appsv1.StatefulSet{ObjectMeta: meta("my-node")}, running)
+		status, err := NewService().Status(ctx, name)
+		assert.Nil(t, err)
+		assert.Equal(t, k8s.StateRunning, status.Phase)
+
+		statuses, err := NewService().Statuses(ctx, "default")
+		assert.Nil(t, err)
+		assert.Equal(t, status, statuses.Of("my-node"))
+	})
+
+	// a statefulset scaled to 0 is scaled back by the operator, the resource is pending until its pod is recreated
+	t.Run("scaled to 0", func(t *testing.T) {
+		replicas := int32(0)
+		newClient(t, &appsv1.StatefulSet{ObjectMeta: meta("my-node"), Spec: appsv1.StatefulSetSpec{Replicas: &replicas}})
+		status, err := NewService().Status(ctx, name)
+		assert.Nil(t, err)
+		assert.Equal(t, k8s.StatusDto{Phase: k8s.StatePending, Reason: "PodNotFound"}, status)
+
+		statuses, err := NewService().Statuses(ctx, "default")
+		assert.Nil(t, err)
+		assert.Equal(t, status, statuses.Of("my-node"))
+	})
+}
@@ -1,12 +1,18 @@
 package logger
 
 import (
-	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
+	"context"
+	"fmt"
 	"os"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 const (
@@ -14,6 +20,23 @@ const (
 	envLogOutput = "LOG_OUTPUT"
 )
 
+// components of the api server, each one has its own log level that can be changed at runtime
+const (
+	// API is the default component, used by the package level log functions
+	API = "api"
+	// HTTP logs the access logs and the unhandled request errors
+	HTTP = "http"
+	// K8s logs the kubernetes clients and the crds discovery
+	K8s = "k8s"
+	// Server logs the server lifecycle and the tls certificates
+	Server = "server"
+	// Stats logs the stats, status and metrics pollers, its logs are sampled
+	Stats = "stats"
+)
+
+// Components is the list of the api server log components
+var Components = []string{API, HTTP, K8s, Server, Stats}
+
 var (
 	log logger
 )
@@ -23,37 +46,203 @@ type Logger interface {
 	Printf(format string, v ...interface{})
 }
 
+// Options configures the logger outputs, format, levels and sampling
+type Options struct {
+	// Level is the default level of all components
+	Level string
+	// Output is stdout, stderr or a file path
+	Output string
+	// Format is json, or console for development
+	Format string
+	// File is an optional rotating file logs are written to in json besides Output
+	File FileOptions
+	// Components overrides the level of single components
+	Components map[string]string
+	// Sampling throttles the logs of the stats component
+	Sampling SamplingOptions
+}
+
+type FileOptions struct {
+	Path string
+	// MaxSize is the size in megabytes the file is rotated at
+	MaxSize int
+	// MaxBackups is the number of rotated files to keep
+	MaxBackups int
+}
+
+// SamplingOptions writes the first Initial identical logs every second, then every Thereafter log
+type SamplingOptions struct {
+	Initial    int
+	Thereafter int
+}
+
 type logger struct {
-	log *zap.Logger
+	lock       sync.RWMutex
+	core       func(level zap.AtomicLevel, sampled bool) zapcore.Core
+	closers    []func()
+	levels     map[string]zap.AtomicLevel
+	components map[string]*zap.Logger
 }
 
 func init() {
-	logConfig := zap.Config{
-		OutputPaths: []string{getOutput()},
-		Level:       zap.NewAtomicLevelAt(getLevel()),
-		Encoding:    "json",
-		EncoderConfig: zapcore.EncoderConfig{
-			TimeKey:      "time",
-			MessageKey:   "msg",
-			LevelKey:     "level",
-			EncodeTime:   zapcore.ISO8601TimeEncoder,
-			EncodeLevel:  zapcore.LowercaseLevelEncoder,
-			EncodeCaller: zapcore.ShortCallerEncoder,
-		},
-	}
-
-	var err error
-	if log.log, err = logConfig.Build(); err != nil {
+	if err := Configure(Options{Level: os.Getenv(envLogLevel), Output: getOutput()}); err != nil {
 		panic(err)
 	}
 }
 
-func getLevel() zapcore.Level {
-	switch strings.ToLower(os.Getenv(envLogLevel)) {
+// Configure replaces the logger with one built from options
+// levels changed at runtime are reset to the configured ones
+func Configure(options Options) error {
+	if options.Output == "" {
+		options.Output = "stdout"
+	}
+
+	var encoder zapcore.Encoder
+	switch strings.ToLower(options.Format) {
+	case "", "json":
+		encoder = zapcore.NewJSONEncoder(jsonEncoderConfig())
+	case "console":
+		encoder = zapcore.NewConsoleEncoder(consoleEncoderConfig())
+	default:
+		return fmt.Errorf("unknown log format %q", options.Format)
+	}
+
+	output, closeOutput, err := zap.Open(options.Output)
+	if err != nil {
+		return err
+	}
+	closers := []func(){closeOutput}
+
+	var file zapcore.WriteSyncer
+	if options.File.Path != "" {
+		rotating, err := newRotatingFile(options.File.Path, int64(options.File.MaxSize)*1024*1024, options.File.MaxBackups)
+		if err != nil {
+			closeOutput()
+			return err
+		}
+		file = rotating
+		closers = append(closers, func() { rotating.Close() })
+	}
+
+	core := func(level zap.AtomicLevel, sampled bool) zapcore.Core {
+		cores := []zapcore.Core{zapcore.NewCore(encoder, output, level)}
+		if file != nil {
+			// the file is always json so it can be shipped
+			cores = append(cores, zapcore.NewCore(zapcore.NewJSONEncoder(jsonEncoderConfig()), file, level))
+		}
+		tee := zapcore.NewTee(cores...)
+		if sampled && options.Sampling.Initial > 0 {
+			return zapcore.NewSamplerWithOptions(tee, time.Second, options.Sampling.Initial, options.Sampling.Thereafter)
+		}
+		return tee
+	}
+
+	levels := map[string]zap.AtomicLevel{}
+	for _, component := range Components {
+		levels[component] = zap.NewAtomicLevelAt(parseLevel(options.Level))
+	}
+	for component, level := range options.Components {
+		levels[component] = zap.NewAtomicLevelAt(parseLevel(level))
+	}
+
+	log.lock.Lock()
+	defer log.lock.Unlock()
+	for _, closeLogger := range log.closers {
+		closeLogger()
+	}
+	log.core = core
+	log.closers = closers
+	log.levels = levels
+	log.components = map[string]*zap.Logger{}
+	return nil
+}
+
+func jsonEncoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:      "time",
+		MessageKey:   "msg",
+		LevelKey:     "level",
+		NameKey:      "component",
+		EncodeTime:   zapcore.ISO8601TimeEncoder,
+		EncodeLevel:  zapcore.LowercaseLevelEncoder,
+		EncodeCaller: zapcore.ShortCallerEncoder,
+		EncodeName:   zapcore.FullNameEncoder,
+	}
+}
+
+// consoleEncoderConfig is a human friendly encoding for development
+func consoleEncoderConfig() zapcore.EncoderConfig {
+	config := zap.NewDevelopmentEncoderConfig()
+	config.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	config.EncodeTime = zapcore.TimeEncoderOfLayout("15:04:05.000")
+	return config
+}
+
+// component returns the zap logger of the given component, creating it on first use
+func (l *logger) component(name string) *zap.Logger {
+	l.lock.RLock()
+	zapLogger, ok := l.components[name]
+	l.lock.RUnlock()
+	if ok {
+		return zapLogger
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if zapLogger, ok = l.components[name]; ok {
+		return zapLogger
+	}
+	level, ok := l.levels[name]
+	if !ok {
+		level = zap.NewAtomicLevelAt(l.levels[API].Level())
+		l.levels[name] = level
+	}
+	zapLogger = zap.New(l.core(level, name == Stats)).Named(name)
+	l.components[name] = zapLogger
+	return zapLogger
+}
+
+// Levels returns the current log level of every component
+func Levels() map[string]string {
+	log.lock.RLock()
+	defer log.lock.RUnlock()
+	levels := map[string]string{}
+	for name, level := range log.levels {
+		levels[name] = level.String()
+	}
+	return levels
+}
+
+// SetLevel changes the log level of the given component at runtime
+func SetLevel(component, level string) error {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil || parsed < zap.DebugLevel || parsed > zap.ErrorLevel {
+		return fmt.Errorf("log level must be one of debug, info, warn or error, got %q", level)
+	}
+
+	log.lock.RLock()
+	defer log.lock.RUnlock()
+	atomicLevel, ok := log.levels[component]
+	if !ok {
+		names := make([]string, 0, len(log.levels))
+		for name := range log.levels {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown log component %q, must be one of %s", component, strings.Join(names, ", "))
+	}
+	atomicLevel.SetLevel(parsed)
+	return nil
+}
+
+func parseLevel(level string) zapcore.Level {
+	switch strings.ToLower(level) {
 	case "debug":
 		return zap.DebugLevel
 	case "info":
 		return zap.InfoLevel
+	case "warn":
+		return zap.WarnLevel
 	case "error":
 		return zap.ErrorLevel
 	default:
@@ -69,49 +258,105 @@ func getOutput() string {
 	return output
 }
 
-func Info(location interface{}, msg string, tags ...zap.Field) {
-	switch location.(type) {
-	case string:
-		tags = append(tags, zap.String("location", location.(string)))
-	default:
-		tags = append(tags, zap.String("location", errorLocation(location)))
+type contextKey struct{}
+
+// NewContext returns a copy of ctx whose logs are tagged with the fields returned by fields
+// fields is evaluated every time a log is written with the returned context
+func NewContext(ctx context.Context, fields func() []zap.Field) context.Context {
+	return context.WithValue(ctx, contextKey{}, fields)
+}
+
+func contextFields(ctx context.Context) []zap.Field {
+	if ctx == nil {
+		return nil
+	}
+	if fields, ok := ctx.Value(contextKey{}).(func() []zap.Field); ok {
+		return fields()
 	}
-	log.log.Info(msg, tags...)
-	log.log.Sync()
+	return nil
 }
 
-func Error(location interface{}, err error, tags ...zap.Field) {
+func locationField(location interface{}) zap.Field {
 	switch location.(type) {
 	case string:
-		tags = append(tags, zap.String("location", location.(string)))
+		return zap.String("location", location.(string))
 	default:
-		tags = append(tags, zap.String("location", errorLocation(location)))
+		return zap.String("location", errorLocation(location))
 	}
+}
+
+// Component logs under the name of a single api server component
+type Component string
+
+func (c Component) Info(location interface{}, msg string, tags ...zap.Field) {
+	log.component(string(c)).Info(msg, append(tags, locationField(location))...)
+}
+
+func (c Component) Error(location interface{}, err error, tags ...zap.Field) {
+	log.component(string(c)).Error(err.Error(), append(tags, locationField(location))...)
+}
+
+func (c Component) Panic(location interface{}, err error, tags ...zap.Field) {
+	log.component(string(c)).Panic(err.Error(), append(tags, locationField(location))...)
+}
+
+func (c Component) Warn(location interface{}, err error, tags ...zap.Field) {
+	log.component(string(c)).Warn(err.Error(), append(tags, locationField(location))...)
+}
+
+// InfoContext logs msg tagged with the request fields carried by ctx
+func (c Component) InfoContext(ctx context.Context, location interface{}, msg string, tags ...zap.Field) {
+	c.Info(location, msg, append(tags, contextFields(ctx)...)...)
+}
 
-	log.log.Error(err.Error(), tags...)
-	log.log.Sync()
+// ErrorContext logs err tagged with the request fields carried by ctx
+func (c Component) ErrorContext(ctx context.Context, location interface{}, err error, tags ...zap.Field) {
+	c.Error(location, err, append(tags, contextFields(ctx)...)...)
+}
+
+// WarnContext logs err tagged with the request fields carried by ctx
+func (c Component) WarnContext(ctx context.Context, location interface{}, err error, tags ...zap.Field) {
+	c.Warn(location, err, append(tags, contextFields(ctx)...)...)
+}
+
+func Info(location interface{}, msg string, tags ...zap.Field) {
+	Component(API).Info(location, msg, tags...)
+}
+
+func Error(location interface{}, err error, tags ...zap.Field) {
+	Component(API).Error(location, err, tags...)
 }
 
 func Panic(location interface{}, err error, tags ...zap.Field) {
-	switch location.(type) {
-	case string:
-		tags = append(tags, zap.String("location", location.(string)))
-	default:
-		tags = append(tags, zap.String("location", errorLocation(location)))
-	}
-	log.log.Panic(err.Error(), tags...)
-	log.log.Sync()
+	Component(API).Panic(location, err, tags...)
 }
 
 func Warn(location interface{}, err error, tags ...zap.Field) {
-	switch location.(type) {
-	case string:
-		tags = append(tags, zap.String("location", location.(string)))
-	default:
-		tags = append(tags, zap.String("location", errorLocation(location)))
+	Component(API).Warn(location, err, tags...)
+}
+
+// InfoContext logs msg tagged with the request fields carried by ctx
+func InfoContext(ctx context.Context, location interface{}, msg string, tags ...zap.Field) {
+	Component(API).InfoContext(ctx, location, msg, tags...)
+}
+
+// ErrorContext logs err tagged with the request fields carried by ctx
+func ErrorContext(ctx context.Context, location interface{}, err error, tags ...zap.Field) {
+	Component(API).ErrorContext(ctx, location, err, tags...)
+}
+
+// WarnContext logs err tagged with the request fields carried by ctx
+func WarnContext(ctx context.Context, location interface{}, err error, tags ...zap.Field) {
+	Component(API).WarnContext(ctx, location, err, tags...)
+}
+
+// Sync flushes any buffered log entries
+func Sync() {
+	log.lock.RLock()
+	defer log.lock.RUnlock()
+	for _, zapLogger := range log.components {
+		zapLogger.Sync()
 	}
-	log.log.Warn(err.Error(), tags...)
-	log.log.Sync()
 }
 
 func errorLocation(temp interface{}) string {
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.log")
+	file, err := newRotatingFile(path, 10, 2)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err = file.Write([]byte(line))
+		assert.Nil(t, err)
+	}
+
+	read := func(name string) string {
+		content, err := os.ReadFile(name)
+		assert.Nil(t, err)
+		return string(content)
+	}
+	assert.Equal(t, "fourth\n", read(path))
+	assert.Equal(t, "third\n", read(path+".1"))
+	assert.Equal(t, "second\n", read(path+".2"))
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSetLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.log")
+	assert.Nil(t, Configure(Options{Level: "info", Output: path, Components: map[string]string{K8s: "error"}}))
+	defer Configure(Options{})
+
+	assert.Equal(t, "info", Levels()[API])
+	assert.Equal(t, "error", Levels()[K8s])
+
+	Component(K8s).Info("TEST", "hidden")
+	assert.Nil(t, SetLevel(K8s, "debug"))
+	Component(K8s).Info("TEST", "shown")
+	Sync()
+
+	content, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(content), "hidden"))
+	assert.True(t, strings.Contains(string(content), `"component":"k8s"`))
+	assert.True(t, strings.Contains(string(content), "shown"))
+
+	assert.NotNil(t, SetLevel(K8s, "verbose"))
+	assert.NotNil(t, SetLevel("unknown", "info"))
+}
@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// defaultMaxSize is the size rotating files are rotated at if no max size is given
+const defaultMaxSize = 100 * 1024 * 1024
+
+// rotatingFile is a log file renamed to path.1 once it exceeds maxSize
+// older files are shifted to path.2 ... path.maxBackups and the oldest one is removed
+type rotatingFile struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("can't create log directory: %w", err)
+	}
+	r := &rotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("can't open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("can't read log file: %w", err)
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+// Write writes p to the file, rotating it first if p doesn't fit
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+
+	if r.maxBackups <= 0 {
+		os.Remove(r.path)
+	} else {
+		os.Remove(r.backup(r.maxBackups))
+		for i := r.maxBackups - 1; i > 0; i-- {
+			os.Rename(r.backup(i), r.backup(i+1))
+		}
+		if err := os.Rename(r.path, r.backup(1)); err != nil {
+			return fmt.Errorf("can't rotate log file: %w", err)
+		}
+	}
+
+	return r.open()
+}
+
+func (r *rotatingFile) backup(i int) string {
+	return fmt.Sprintf("%s.%d", r.path, i)
+}
+
+func (r *rotatingFile) Sync() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.file.Sync()
+}
+
+func (r *rotatingFile) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.file.Close()
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// AccessLog writes a log for every request in the same json format as the rest of the logs
+// it's mounted after RequestID so the access log carries the request fields
+func AccessLog(c *fiber.Ctx) error {
+	start := time.Now()
+
+	if err := c.Next(); err != nil {
+		if err = c.App().ErrorHandler(c, err); err != nil {
+			return err
+		}
+	}
+
+	status := c.Response().StatusCode()
+	logger.Component(logger.HTTP).InfoContext(c.UserContext(), "ACCESS_LOG", fmt.Sprintf("%s %s %d", c.Method(), c.Path(), status),
+		zap.Int("status", status),
+		zap.String("path", c.Path()),
+		zap.Duration("latency", time.Since(start)),
+		zap.String("ip", c.IP()),
+		zap.Int("bytes", len(c.Response().Body())),
+		zap.String("user_agent", c.Get(fiber.HeaderUserAgent)),
+	)
+
+	return nil
+}
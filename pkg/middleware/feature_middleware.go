@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	restError "github.com/kotalco/community-api/pkg/errors"
+)
+
+// Feature rejects requests to an optional feature that has been disabled in the configuration
+func Feature(name string, enabled bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !enabled {
+			notImplementedErr := restError.NewNotImplementedError(fmt.Sprintf("%s feature is disabled", name))
+			return c.Status(notImplementedErr.StatusCode()).JSON(notImplementedErr)
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/pkg/configs"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/idempotency"
+	"github.com/kotalco/community-api/pkg/ratelimit"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+var (
+	idempotencyStore     *idempotency.Store
+	idempotencyStoreOnce sync.Once
+)
+
+// Idempotency replays the recorded response of create requests retried with the same Idempotency-Key header and body
+// keys are scoped to the client, the namespace and the path, and recorded for the configured retention
+// reusing a key with a different body is rejected with 422, and with 409 while the first request is in progress
+// server errors aren't recorded so the request can be retried
+func Idempotency(c *fiber.Ctx) error {
+	key := c.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return c.Next()
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		badReq := restErrors.NewBadRequestError(fmt.Sprintf("%s header can't be longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength))
+		return c.Status(badReq.StatusCode()).JSON(badReq)
+	}
+
+	idempotencyStoreOnce.Do(func() {
+		idempotencyStore = idempotency.NewStore(configs.Settings.Idempotency.Retention.Duration())
+	})
+
+	scopedKey := strings.Join([]string{ratelimit.ClientKey(c), c.Locals("namespace").(string), c.Path(), key}, "|")
+
+	result, response := idempotencyStore.Begin(scopedKey, c.Body())
+	switch result {
+	case idempotency.Mismatch:
+		unprocessableErr := restErrors.NewUnprocessableEntityError(fmt.Sprintf("%s %s has been used with a different request body", IdempotencyKeyHeader, key))
+		return c.Status(unprocessableErr.StatusCode()).JSON(unprocessableErr)
+	case idempotency.InProgress:
+		conflictErr := restErrors.NewConflictError(fmt.Sprintf("a request with %s %s is in progress", IdempotencyKeyHeader, key))
+		return c.Status(conflictErr.StatusCode()).JSON(conflictErr)
+	case idempotency.Replay:
+		c.Set(IdempotentReplayedHeader, "true")
+		c.Set(fiber.HeaderContentType, response.ContentType)
+		return c.Status(response.Status).Send(response.Body)
+	}
+
+	if err := c.Next(); err != nil {
+		idempotencyStore.Abort(scopedKey)
+		return err
+	}
+
+	status := c.Response().StatusCode()
+	if status >= fiber.StatusInternalServerError {
+		idempotencyStore.Abort(scopedKey)
+		return nil
+	}
+
+	idempotencyStore.Complete(scopedKey, idempotency.Response{
+		Status:      status,
+		ContentType: string(c.Response().Header.ContentType()),
+		Body:        append([]byte(nil), c.Response().Body()...),
+	})
+	return nil
+}
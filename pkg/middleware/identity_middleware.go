@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetIdentity maps the verified client certificate of mTLS connections to the caller identity
+// the identity is the certificate subject common name, or the full subject if it has no common name
+func SetIdentity(c *fiber.Ctx) error {
+	state := c.Context().TLSConnectionState()
+	if state != nil && len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 0 {
+		subject := state.VerifiedChains[0][0].Subject
+		identity := subject.CommonName
+		if identity == "" {
+			identity = subject.String()
+		}
+		c.Locals("identity", identity)
+	}
+	return c.Next()
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/pkg/configs"
+	restError "github.com/kotalco/community-api/pkg/errors"
+	"strings"
+)
+
+// IsAdmin authorizes admin api calls using the configured admin bearer token
+// the admin api is disabled if no admin token is configured
+func IsAdmin(c *fiber.Ctx) error {
+	token := configs.Settings.Admin.Token
+	if token == "" {
+		forbiddenErr := restError.NewForbiddenError("admin api is disabled, admin token isn't configured")
+		return c.Status(forbiddenErr.StatusCode()).JSON(forbiddenErr)
+	}
+
+	bearer := strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+		unAuthorizedErr := restError.NewUnAuthorizedError("invalid admin token")
+		return c.Status(unAuthorizedErr.StatusCode()).JSON(unAuthorizedErr)
+	}
+
+	return c.Next()
+}
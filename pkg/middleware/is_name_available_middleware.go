@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kotalco/community-api/internal/core/name"
+	restError "github.com/kotalco/community-api/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var nameService = name.NewNameService()
+
+// IsNameAvailable rejects create requests with 409 if the name is used by a kotal resource of any kind
+// or by a statefulset, service, configmap or secret in the namespace
+// bodies without a string name are left to the handler to validate
+func IsNameAvailable(c *fiber.Ctx) error {
+	body := struct {
+		Name string `json:"name"`
+	}{}
+	if err := c.BodyParser(&body); err != nil || body.Name == "" {
+		return c.Next()
+	}
+
+	dto, err := nameService.Get(c.UserContext(), types.NamespacedName{
+		Namespace: c.Locals("namespace").(string),
+		Name:      body.Name,
+	})
+	if err != nil {
+		return c.Status(err.StatusCode()).JSON(err)
+	}
+	if !dto.Available {
+		conflictErr := restError.NewConflictError(fmt.Sprintf("resource %s already exists!", body.Name))
+		return c.Status(conflictErr.StatusCode()).JSON(conflictErr)
+	}
+
+	return c.Next()
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	restError "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/k8s"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IsServed rejects requests to a protocol resource of kind gvk the kotal operator in the cluster doesn't serve
+// the resource is looked up in k8s.KotalKinds
+func IsServed(gvk schema.GroupVersionKind) fiber.Handler {
+	kind, ok := k8s.KindFor(gvk)
+	if !ok {
+		panic(fmt.Sprintf("can't check if unknown kind %s is served", gvk))
+	}
+	gvr := kind.GroupVersionResource()
+
+	return func(c *fiber.Ctx) error {
+		if !k8s.Discovery().IsServed(gvr) {
+			notImplementedErr := restError.NewNotImplementedError(fmt.Sprintf("%s.%s/%s isn't installed in the cluster", gvr.Resource, gvr.Group, gvr.Version))
+			return c.Status(notImplementedErr.StatusCode()).JSON(notImplementedErr)
+		}
+		return c.Next()
+	}
+}
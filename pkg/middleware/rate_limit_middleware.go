@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"github.com/kotalco/community-api/pkg/configs"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/ratelimit"
+)
+
+// RateLimit limits every client to the reads, writes and websocket opens budgets of settings
+// requests over budget are rejected with 429 and a Retry-After header
+func RateLimit(settings configs.RateLimitConfig) fiber.Handler {
+	if !settings.Enabled {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	reads := ratelimit.NewLimiter(settings.Reads.PerMinute, settings.Reads.Burst)
+	writes := ratelimit.NewLimiter(settings.Writes.PerMinute, settings.Writes.Burst)
+	websocketOpens := ratelimit.NewLimiter(settings.WebsocketOpens.PerMinute, settings.WebsocketOpens.Burst)
+
+	return func(c *fiber.Ctx) error {
+		limiter, budget := writes, "writes"
+		switch {
+		case websocket.IsWebSocketUpgrade(c):
+			limiter, budget = websocketOpens, "websocket opens"
+		case c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead || c.Method() == fiber.MethodOptions:
+			limiter, budget = reads, "reads"
+		}
+
+		allowed, retryAfter := limiter.Allow(ratelimit.ClientKey(c))
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(seconds))
+			tooManyErr := restErrors.NewTooManyRequestsError(fmt.Sprintf("%s rate limit exceeded, retry after %d seconds", budget, seconds))
+			return c.Status(tooManyErr.StatusCode()).JSON(tooManyErr)
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/logger"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	// maxRequestIDLength bounds the propagated request id, longer ids are replaced
+	maxRequestIDLength = 128
+)
+
+// RequestID propagates the X-Request-ID header or generates a new id, and returns it in the response header
+// every log written with the request user context is tagged with the request id, namespace, route, resource name and identity
+// error bodies returned by the request are tagged with the request id
+func RequestID(c *fiber.Ctx) error {
+	id := c.Get(RequestIDHeader)
+	if id == "" || len(id) > maxRequestIDLength {
+		id = utils.UUIDv4()
+	} else {
+		id = utils.CopyString(id)
+	}
+	c.Set(RequestIDHeader, id)
+	c.Locals("requestId", id)
+
+	// fields are evaluated lazily because namespace and route are only known down the chain
+	// and frozen once the request is handled, websocket streams keep logging after the fiber ctx is released
+	var frozen atomic.Value
+	c.SetUserContext(logger.NewContext(c.UserContext(), func() []zap.Field {
+		if fields, ok := frozen.Load().([]zap.Field); ok {
+			return fields
+		}
+		return requestFields(c, id)
+	}))
+
+	if err := c.Next(); err != nil {
+		if err = c.App().ErrorHandler(c, err); err != nil {
+			return err
+		}
+	}
+	frozen.Store(requestFields(c, id))
+
+	if c.Response().StatusCode() >= fiber.StatusBadRequest {
+		tagErrorBody(c, id)
+	}
+
+	return nil
+}
+
+// requestFields returns the log fields of the request handled by c
+// strings backed by the request are copied, the fields are read after fasthttp recycles c
+func requestFields(c *fiber.Ctx, id string) []zap.Field {
+	fields := []zap.Field{
+		zap.String("request_id", id),
+		zap.String("method", utils.CopyString(c.Method())),
+		zap.String("route", utils.CopyString(c.Route().Path)),
+	}
+	if namespace, ok := c.Locals("namespace").(string); ok {
+		fields = append(fields, zap.String("namespace", namespace))
+	}
+	if name := c.Params("name"); name != "" {
+		fields = append(fields, zap.String("name", utils.CopyString(name)))
+	}
+	if identity, ok := c.Locals("identity").(string); ok {
+		fields = append(fields, zap.String("identity", identity))
+	}
+	if spanContext := trace.SpanContextFromContext(c.UserContext()); spanContext.IsValid() {
+		fields = append(fields, zap.String("trace_id", spanContext.TraceID().String()))
+	}
+	return fields
+}
+
+// tagErrorBody sets the request id of the rest error written in the response body
+func tagErrorBody(c *fiber.Ctx, id string) {
+	restErr := restErrors.RestErr{}
+	if err := json.Unmarshal(c.Response().Body(), &restErr); err != nil || restErr.Status != c.Response().StatusCode() {
+		return
+	}
+	restErr.RequestID = id
+	body, err := json.Marshal(restErr)
+	if err != nil {
+		return
+	}
+	c.Response().SetBodyRaw(body)
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/kotalco/community-api/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing records a server span for every request, continuing the trace propagated by the client if any
+// the span is stored in the request user context so services and outgoing calls spans are its children
+func Tracing(c *fiber.Ctx) error {
+	ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), headerCarrier{c})
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("HTTP %s", c.Method()),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(c.Method()),
+			semconv.HTTPTargetKey.String(utils.CopyString(c.OriginalURL())),
+		),
+	)
+	defer span.End()
+	c.SetUserContext(ctx)
+
+	if err := c.Next(); err != nil {
+		if err = c.App().ErrorHandler(c, err); err != nil {
+			return err
+		}
+	}
+
+	// the route is known once the request is matched
+	route := c.Route().Path
+	status := c.Response().StatusCode()
+	span.SetName(fmt.Sprintf("%s %s", c.Method(), route))
+	span.SetAttributes(semconv.HTTPRouteKey.String(route), semconv.HTTPStatusCodeKey.Int(status))
+	if status >= fiber.StatusInternalServerError {
+		span.SetStatus(codes.Error, utils.StatusMessage(status))
+	}
+
+	return nil
+}
+
+// headerCarrier reads and writes propagation headers of fiber requests
+type headerCarrier struct {
+	c *fiber.Ctx
+}
+
+func (h headerCarrier) Get(key string) string {
+	return h.c.Get(key)
+}
+
+func (h headerCarrier) Set(key, value string) {
+	h.c.Request().Header.Set(key, value)
+}
+
+func (h headerCarrier) Keys() []string {
+	keys := []string{}
+	h.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
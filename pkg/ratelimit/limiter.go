@@ -0,0 +1,128 @@
+// Package ratelimit limits the requests rate and the concurrent streams of every api client
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// cleanupInterval is how often idle clients are forgotten
+	cleanupInterval = time.Minute
+	// idleTimeout is how long a client is remembered after its last request
+	idleTimeout = 10 * time.Minute
+)
+
+// ClientKey identifies the api client of the request by its identity, or by its ip if it has no identity
+func ClientKey(c *fiber.Ctx) string {
+	if identity, ok := c.Locals("identity").(string); ok && identity != "" {
+		return "identity:" + identity
+	}
+	return "ip:" + c.IP()
+}
+
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter is a token bucket per client refilled at perMinute tokens a minute up to burst tokens
+type Limiter struct {
+	lock        sync.Mutex
+	limit       rate.Limit
+	burst       int
+	clients     map[string]*client
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+// NewLimiter returns a limiter allowing perMinute requests a minute per client, with bursts of up to burst requests
+func NewLimiter(perMinute, burst int) *Limiter {
+	if burst <= 0 {
+		burst = 1
+	}
+	return &Limiter{
+		limit:   rate.Limit(float64(perMinute) / time.Minute.Seconds()),
+		burst:   burst,
+		clients: map[string]*client{},
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the bucket of key
+// if the bucket is empty it returns false and how long to wait before retrying
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	c, ok := l.clients[key]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[key] = c
+	}
+	c.lastSeen = now
+
+	reservation := c.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return false, time.Duration(math.MaxInt64)
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// cleanup forgets the clients that have been idle for idleTimeout
+func (l *Limiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < cleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+	for key, c := range l.clients {
+		if now.Sub(c.lastSeen) > idleTimeout {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// Concurrency caps the number of concurrent operations per client
+type Concurrency struct {
+	lock   sync.Mutex
+	max    int
+	counts map[string]int
+}
+
+// NewConcurrency returns a cap of max concurrent operations per client, 0 is unlimited
+func NewConcurrency(max int) *Concurrency {
+	return &Concurrency{max: max, counts: map[string]int{}}
+}
+
+// Acquire reserves an operation for key, it returns false if key reached the cap
+func (c *Concurrency) Acquire(key string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.max > 0 && c.counts[key] >= c.max {
+		return false
+	}
+	c.counts[key]++
+	return true
+}
+
+// Release releases an operation acquired by key
+func (c *Concurrency) Release(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.counts[key] <= 1 {
+		delete(c.counts, key)
+		return
+	}
+	c.counts[key]--
+}
@@ -0,0 +1,58 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewLimiter(60, 2)
+	limiter.now = func() time.Time { return now }
+
+	allowed, _ := limiter.Allow("a")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("a")
+	assert.True(t, allowed)
+
+	allowed, retryAfter := limiter.Allow("a")
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// other clients have their own budget
+	allowed, _ = limiter.Allow("b")
+	assert.True(t, allowed)
+
+	// a token is refilled every second
+	now = now.Add(time.Second)
+	allowed, _ = limiter.Allow("a")
+	assert.True(t, allowed)
+
+	// idle clients are forgotten
+	now = now.Add(idleTimeout + cleanupInterval)
+	limiter.Allow("a")
+	assert.Len(t, limiter.clients, 1)
+}
+
+func TestConcurrency(t *testing.T) {
+	concurrency := NewConcurrency(2)
+
+	assert.True(t, concurrency.Acquire("a"))
+	assert.True(t, concurrency.Acquire("a"))
+	assert.False(t, concurrency.Acquire("a"))
+	assert.True(t, concurrency.Acquire("b"))
+
+	concurrency.Release("a")
+	assert.True(t, concurrency.Acquire("a"))
+
+	concurrency.Release("a")
+	concurrency.Release("a")
+	assert.Empty(t, concurrency.counts["a"])
+
+	unlimited := NewConcurrency(0)
+	for i := 0; i < 10; i++ {
+		assert.True(t, unlimited.Acquire("a"))
+	}
+}
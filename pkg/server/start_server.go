@@ -1,38 +1,84 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/kotalco/community-api/pkg/configs"
 	"github.com/kotalco/community-api/pkg/logger"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 )
 
+var serverLogger = logger.Component(logger.Server)
+
+// shutdown is closed once the server received a termination signal
+var shutdown = make(chan struct{})
+
+// ShuttingDown returns a channel closed once the server starts shutting down
+// background workers use it to stop
+func ShuttingDown() <-chan struct{} {
+	return shutdown
+}
+
 // StartServerWithGracefulShutdown function for starting server with a graceful shutdown.
 // Create channel for idle connections.
-// check if  Received an interrupt signal, shutdown.
+// check if  Received an interrupt or termination signal, shutdown.
+// Drain open websockets then in-flight requests until the shutdown timeout
 // Error if closing listeners, or context timeout
-// Run server.
-// Error if  Run server with reason
+// Run server over tls if it's enabled in the configuration.
+// Exit with a non-zero code if the server can't run, like a failure to load the tls certificate or to listen on the port
 func StartServerWithGracefulShutdown(a *fiber.App) {
 	idleConnsClosed := make(chan struct{})
 
 	go func() {
 		sigint := make(chan os.Signal, 1)
-		signal.Notify(sigint, syscall.SIGINT) // Catch OS signals.
+		signal.Notify(sigint, syscall.SIGINT, syscall.SIGTERM) // Catch OS signals.
 		<-sigint
-		if err := a.Shutdown(); err != nil {
-			go logger.Info("StartServerWithGracefulShutdown", fmt.Sprintf("Oops... Server is not shutting down! Reason:  %v", err))
+		close(shutdown)
+
+		ctx, cancel := context.WithTimeout(context.Background(), configs.Settings.Server.ShutdownTimeout.Duration())
+		defer cancel()
+
+		if err := openStreams.drain(ctx); err != nil {
+			serverLogger.Info("StartServerWithGracefulShutdown", fmt.Sprintf("Oops... websockets are not drained! Reason:  %v", err))
+		}
+		if err := a.Server().ShutdownWithContext(ctx); err != nil {
+			serverLogger.Info("StartServerWithGracefulShutdown", fmt.Sprintf("Oops... Server is not shutting down! Reason:  %v", err))
 		}
 		close(idleConnsClosed)
 	}()
 
-	port := ":" + configs.Environment.ServerPort
+	port := fmt.Sprintf(":%d", configs.Settings.Server.Port)
 
-	if err := a.Listen(port); err != nil {
-		go logger.Info("StartServerWithGracefulShutdown", fmt.Sprintf("Oops... Server is not running! Reason: %v", err))
+	// the server can't start, exit instead of waiting for a shutdown signal that won't come
+	if err := listen(a, port, shutdown); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		serverLogger.Error("StartServerWithGracefulShutdown", fmt.Errorf("Oops... Server is not running! Reason: %w", err))
+		os.Exit(1)
 	}
 	<-idleConnsClosed
 }
+
+// listen serves plain http, or https if tls is enabled in the configuration
+func listen(a *fiber.App, addr string, stop <-chan struct{}) error {
+	if !configs.Settings.TLS.Enabled {
+		return a.Listen(addr)
+	}
+
+	tlsConfig, err := TLSConfig(configs.Settings.TLS, stop)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return a.Listener(tls.NewListener(ln, tlsConfig))
+}
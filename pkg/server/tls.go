@@ -0,0 +1,185 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kotalco/community-api/pkg/configs"
+)
+
+// TLSConfig returns the server tls config from the tls settings
+// certificate files are checked every reload interval and reloaded when rotated until stop is closed
+func TLSConfig(settings configs.TLSConfig, stop <-chan struct{}) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if settings.SelfSigned {
+		certificate, err := selfSignedCertificate()
+		if err != nil {
+			return nil, fmt.Errorf("can't generate self-signed certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	} else {
+		reloader, err := newCertificateReloader(settings.CertFile, settings.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		go reloader.watch(settings.ReloadInterval.Duration(), stop)
+		tlsConfig.GetCertificate = reloader.GetCertificate
+	}
+
+	if settings.ClientCAFile != "" {
+		pem, err := os.ReadFile(settings.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("can't read client ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("client ca file %s doesn't contain any pem certificate", settings.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if settings.RequireClientCert {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// certificateReloader serves the key pair at certFile and keyFile
+// and reloads it when the files are modified, like mounted k8s secrets on rotation
+type certificateReloader struct {
+	certFile    string
+	keyFile     string
+	lock        sync.RWMutex
+	certificate *tls.Certificate
+	modTime     time.Time
+}
+
+func newCertificateReloader(certFile, keyFile string) (*certificateReloader, error) {
+	reloader := &certificateReloader{certFile: certFile, keyFile: keyFile}
+	if _, err := reloader.reload(); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// reload loads the key pair if any of the files has been modified since the last load
+// it returns true if the certificate has been replaced
+func (r *certificateReloader) reload() (bool, error) {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.RLock()
+	unchanged := r.certificate != nil && !modTime.After(r.modTime)
+	r.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("can't load tls key pair: %w", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.certificate = &certificate
+	r.modTime = modTime
+
+	return true, nil
+}
+
+func (r *certificateReloader) lastModified() (modTime time.Time, err error) {
+	for _, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return modTime, fmt.Errorf("can't read tls file: %w", err)
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	return
+}
+
+// watch reloads the certificate every interval until stop is closed
+// a failed reload keeps serving the previous certificate
+func (r *certificateReloader) watch(interval time.Duration, stop <-chan struct{}) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			reloaded, err := r.reload()
+			if err != nil {
+				serverLogger.Warn("TLS_CERTIFICATE_RELOAD", err)
+			} else if reloaded {
+				serverLogger.Info("TLS_CERTIFICATE_RELOAD", fmt.Sprintf("reloaded tls certificate %s", r.certFile))
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// GetCertificate returns the last loaded certificate
+func (r *certificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if r.certificate == nil {
+		return nil, errors.New("tls certificate isn't loaded")
+	}
+	return r.certificate, nil
+}
+
+// selfSignedCertificate generates a certificate for localhost valid for a year
+func selfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "localhost", Organization: []string{"Kotal API"}},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKeyPair(t *testing.T, certFile, keyFile string) {
+	certificate, err := selfSignedCertificate()
+	assert.Nil(t, err)
+	key, err := x509.MarshalPKCS8PrivateKey(certificate.PrivateKey)
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Certificate[0]}), 0600))
+	assert.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0600))
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	writeKeyPair(t, certFile, keyFile)
+
+	reloader, err := newCertificateReloader(certFile, keyFile)
+	assert.Nil(t, err)
+	first, err := reloader.GetCertificate(nil)
+	assert.Nil(t, err)
+
+	reloaded, err := reloader.reload()
+	assert.Nil(t, err)
+	assert.False(t, reloaded)
+
+	// rotate the key pair
+	writeKeyPair(t, certFile, keyFile)
+	future := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(certFile, future, future))
+
+	reloaded, err = reloader.reload()
+	assert.Nil(t, err)
+	assert.True(t, reloaded)
+	second, err := reloader.GetCertificate(nil)
+	assert.Nil(t, err)
+	assert.NotEqual(t, first.Certificate[0], second.Certificate[0])
+}
+
+func TestCertificateReloaderMissingFiles(t *testing.T) {
+	_, err := newCertificateReloader("missing.crt", "missing.key")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"github.com/kotalco/community-api/pkg/configs"
+	restErrors "github.com/kotalco/community-api/pkg/errors"
+	"github.com/kotalco/community-api/pkg/ratelimit"
+)
+
+const (
+	contextKeyword = "context"
+	cancelKeyword  = "cancel"
+	clientKeyword  = "client"
+	shutdownReason = "server is shutting down"
+	// closeFrameTimeout bounds writing the close frame to a single stream
+	closeFrameTimeout = 100 * time.Millisecond
+)
+
+// streams tracks the open websockets so they can be drained on shutdown
+type streams struct {
+	lock     sync.Mutex
+	draining bool
+	conns    map[*websocket.Conn]context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+var openStreams = &streams{conns: map[*websocket.Conn]context.CancelFunc{}}
+
+// clientStreams caps the concurrent streams of every client
+var clientStreams *ratelimit.Concurrency
+var clientStreamsOnce sync.Once
+
+// Websocket upgrades the request and runs handler as a stream tracked for graceful shutdown
+// the stream context returned by StreamContext is canceled when the client disconnects or the server drains
+// new streams are rejected with 503 once the server started draining
+// and with 429 once the client reached its max concurrent streams if rate limiting is enabled
+func Websocket(handler func(*websocket.Conn)) fiber.Handler {
+	clientStreamsOnce.Do(func() {
+		max := 0
+		if configs.Settings.RateLimit.Enabled {
+			max = configs.Settings.RateLimit.MaxStreamsPerClient
+		}
+		clientStreams = ratelimit.NewConcurrency(max)
+	})
+
+	upgrade := websocket.New(func(c *websocket.Conn) {
+		defer clientStreams.Release(c.Locals(clientKeyword).(string))
+
+		cancel := c.Locals(cancelKeyword).(context.CancelFunc)
+		if !openStreams.add(c, cancel) {
+			c.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, shutdownReason), time.Now().Add(time.Second))
+			return
+		}
+		defer openStreams.remove(c)
+
+		go cancelOnDisconnect(c, cancel)
+		handler(c)
+	})
+
+	return func(c *fiber.Ctx) error {
+		if openStreams.isDraining() {
+			unavailableErr := restErrors.NewServiceUnavailableError(shutdownReason)
+			return c.Status(unavailableErr.StatusCode()).JSON(unavailableErr)
+		}
+
+		client := ratelimit.ClientKey(c)
+		if !clientStreams.Acquire(client) {
+			tooManyErr := restErrors.NewTooManyRequestsError("max concurrent streams reached, close a stream and retry")
+			c.Set(fiber.HeaderRetryAfter, "1")
+			return c.Status(tooManyErr.StatusCode()).JSON(tooManyErr)
+		}
+
+		// the stream context is derived from the request context to keep the request log fields
+		ctx, cancel := context.WithCancel(c.UserContext())
+		c.Locals(contextKeyword, ctx)
+		c.Locals(cancelKeyword, cancel)
+		c.Locals(clientKeyword, client)
+
+		err := upgrade(c)
+		if err != nil {
+			// the stream handler won't run to release the stream
+			cancel()
+			clientStreams.Release(client)
+		}
+		return err
+	}
+}
+
+// StreamContext returns the context of a websocket started by Websocket
+func StreamContext(c *websocket.Conn) context.Context {
+	if ctx, ok := c.Locals(contextKeyword).(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+// Sleep waits for d, returns false if ctx is done first
+func Sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// cancelOnDisconnect reads from the socket until the client goes away then cancels the stream context
+func cancelOnDisconnect(c *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+	for {
+		if _, _, err := c.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *streams) add(c *websocket.Conn, cancel context.CancelFunc) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.draining {
+		cancel()
+		return false
+	}
+	s.conns[c] = cancel
+	s.wg.Add(1)
+	return true
+}
+
+func (s *streams) remove(c *websocket.Conn) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if cancel, ok := s.conns[c]; ok {
+		cancel()
+		delete(s.conns, c)
+		s.wg.Done()
+	}
+}
+
+func (s *streams) isDraining() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.draining
+}
+
+// drain stops accepting new streams, sends a close frame to every open stream, cancels their context
+// and waits for their handlers to return until ctx is done
+func (s *streams) drain(ctx context.Context) error {
+	// the lock is held while writing close frames so no handler returns and releases its conn meanwhile
+	s.lock.Lock()
+	s.draining = true
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseGoingAway, shutdownReason)
+	for c, cancel := range s.conns {
+		c.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(closeFrameTimeout))
+		cancel()
+	}
+	s.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
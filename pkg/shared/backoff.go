@@ -0,0 +1,34 @@
+package shared
+
+import "time"
+
+// Backoff computes exponentially growing delays between retries
+// starting at Min and doubling on every call to Next up to Max
+type Backoff struct {
+	Min     time.Duration
+	Max     time.Duration
+	attempt uint
+}
+
+// NewBackoff returns a backoff that grows from min to max
+func NewBackoff(min, max time.Duration) *Backoff {
+	return &Backoff{Min: min, Max: max}
+}
+
+// Next returns the delay before the next retry
+func (b *Backoff) Next() time.Duration {
+	delay := b.Min
+	for i := uint(0); i < b.attempt && delay < b.Max; i++ {
+		delay *= 2
+	}
+	if delay > b.Max {
+		delay = b.Max
+	}
+	b.attempt++
+	return delay
+}
+
+// Reset restarts the backoff from Min
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
@@ -0,0 +1,22 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	backoff := NewBackoff(time.Second, 10*time.Second)
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for _, want := range expected {
+		if got := backoff.Next(); got != want {
+			t.Errorf("expected delay to be %s, got %s", want, got)
+		}
+	}
+
+	backoff.Reset()
+	if got := backoff.Next(); got != time.Second {
+		t.Errorf("expected delay after reset to be %s, got %s", time.Second, got)
+	}
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HTTPClient returns an http client that records a client span for every request as a child of the span in ctx
+// requests built without a context, like the json-rpc client ones, are bound to ctx
+// json-rpc requests spans are named after the called rpc method
+func HTTPClient(ctx context.Context, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &transport{ctx: ctx, base: http.DefaultTransport},
+	}
+}
+
+type transport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if ctx == context.Background() {
+		ctx = t.ctx
+	}
+
+	name := fmt.Sprintf("HTTP %s", req.Method)
+	attributes := []attribute.KeyValue{
+		semconv.HTTPMethodKey.String(req.Method),
+		semconv.HTTPURLKey.String(redactURL(req)),
+		semconv.NetPeerNameKey.String(req.URL.Hostname()),
+	}
+	if method := jsonRPCMethod(req); method != "" {
+		name = fmt.Sprintf("JSON-RPC %s", method)
+		attributes = append(attributes, semconv.RPCSystemKey.String("jsonrpc"), semconv.RPCMethodKey.String(method))
+	}
+
+	ctx, span := Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	defer span.End()
+
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
+// redactURL returns the request url without user credentials, like bitcoin json-rpc ones
+func redactURL(req *http.Request) string {
+	url := *req.URL
+	url.User = nil
+	return url.String()
+}
+
+// jsonRPCMethod returns the method of json-rpc requests, the request body is restored after reading it
+func jsonRPCMethod(req *http.Request) string {
+	if req.Body == nil || req.Method != http.MethodPost || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		return ""
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	call := struct {
+		JSONRPC string `json:"jsonrpc"`
+		Method  string `json:"method"`
+	}{}
+	if json.Unmarshal(body, &call) != nil || call.JSONRPC == "" {
+		return ""
+	}
+	return call.Method
+}
@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ybbus/jsonrpc/v2"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+func TestHTTPClientJSONRPCSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	var body string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := io.ReadAll(r.Body)
+		body = string(content)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":0,"result":"0x10"}`))
+	}))
+	defer node.Close()
+
+	ctx, parent := Start(context.Background(), "stats")
+	endpoint := strings.Replace(node.URL, "http://", "http://user:password@", 1)
+	client := jsonrpc.NewClientWithOpts(endpoint, &jsonrpc.RPCClientOpts{HTTPClient: HTTPClient(ctx, 0)})
+
+	var peers string
+	assert.Nil(t, client.CallFor(&peers, "net_peerCount"))
+	parent.End()
+
+	assert.Equal(t, "0x10", peers)
+	assert.True(t, strings.Contains(body, `"method":"net_peerCount"`))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	call := spans[0]
+	assert.Equal(t, "JSON-RPC net_peerCount", call.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), call.Parent().SpanID())
+	for _, attribute := range call.Attributes() {
+		if attribute.Key == semconv.HTTPURLKey {
+			assert.Equal(t, node.URL, attribute.Value.AsString())
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Package tracing configures the opentelemetry tracer provider
+// and instruments the api server requests, kubernetes calls and outgoing http and json-rpc calls
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/kotalco/community-api"
+
+// exporters
+const (
+	None   = "none"
+	Stdout = "stdout"
+	OTLP   = "otlp"
+)
+
+// Options configures the exporter and sampling of spans
+type Options struct {
+	// Exporter is none, stdout or otlp
+	Exporter string
+	// Endpoint is the otlp http collector host:port, defaults to OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4318
+	Endpoint string
+	// Insecure exports to the otlp collector over plain http
+	Insecure bool
+	// SampleRatio is the ratio of traces that are sampled, parent sampling decisions are respected
+	SampleRatio float64
+	ServiceName string
+}
+
+// Configure sets the global tracer provider and propagators from options
+// spans aren't recorded if the exporter is none
+// it returns a function that flushes and stops the exporter
+func Configure(ctx context.Context, options Options) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	switch options.Exporter {
+	case "", None:
+		return func(context.Context) error { return nil }, nil
+	case Stdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case OTLP:
+		opts := []otlptracehttp.Option{}
+		if options.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(options.Endpoint))
+		}
+		if options.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", options.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't create %s tracing exporter: %w", options.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(options.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the api server tracer from the global tracer provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a span named name as a child of the span in ctx
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End records err on span if it's not nil, then ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}